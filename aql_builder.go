@@ -0,0 +1,315 @@
+package arango
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//AqlBuilder builds an AqlQuery one operation at a time so values
+//never have to be pasted into the query text:
+//
+//  q, err := arango.For("u").In(users).
+//      Filter("u.age >= ? AND u.name != ?", 21, name).
+//      Sort("u.name").
+//      Limit(10).
+//      Return("u").
+//      Query()
+//
+//The expressions passed to the builder are AQL text. Every ? in
+//them is replaced by a bind parameter holding the next argument.
+//Arguments are bound as values, strings included, except for
+//*Collection arguments which are bound as collections. Wrap AQL
+//that should be used as is, like a variable, in AqlExpr. The names
+//of the bind parameters are picked by the builder and may change.
+//
+//A ? inside a string literal or a comment is left alone. Write ??
+//for a ? that belongs to the query, like in the ternary operator:
+//
+//  Return("u.age >= ? ?? 'adult' : 'minor'", 18)
+//
+//The first mistake made while building, like a missing argument,
+//is returned by Query.
+type AqlBuilder struct {
+	parts       []string
+	bindVars    map[string]interface{}
+	params      int
+	collections map[string]string
+
+	//set between For and In
+	forVariable string
+	err         error
+}
+
+//AqlExpr is AQL text that the builder uses as is instead
+//of binding it as a value.
+type AqlExpr string
+
+var aqlVariable = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+//NewAqlBuilder returns an empty builder.
+func NewAqlBuilder() *AqlBuilder {
+	return &AqlBuilder{
+		bindVars:    make(map[string]interface{}),
+		collections: make(map[string]string),
+	}
+}
+
+//For starts a new builder with FOR variable. Follow it with In.
+func For(variable string) *AqlBuilder {
+	return NewAqlBuilder().For(variable)
+}
+
+//For adds a FOR variable operation. Follow it with In.
+func (b *AqlBuilder) For(variable string) *AqlBuilder {
+	if !b.ready("FOR") {
+		return b
+	}
+	if !aqlVariable.MatchString(variable) {
+		b.fail(fmt.Sprintf("%q is not a valid variable name.", variable))
+		return b
+	}
+	b.forVariable = variable
+	return b
+}
+
+//In completes a For with what to iterate over. source can be
+//a *Collection or a collection name, which are bound as a
+//collection, an AqlExpr or any other value, which is bound as a
+//value, like a slice.
+func (b *AqlBuilder) In(source interface{}) *AqlBuilder {
+	if b.err != nil {
+		return b
+	}
+	if b.forVariable == "" {
+		b.fail("In must follow For.")
+		return b
+	}
+
+	var in string
+	if name, ok := source.(string); ok {
+		if name == "" {
+			b.fail("In needs a collection name.")
+			return b
+		}
+		in = b.bindCollection(name)
+	} else {
+		in = b.bind(source)
+	}
+
+	b.parts = append(b.parts, fmt.Sprintf("FOR %s IN %s", b.forVariable, in))
+	b.forVariable = ""
+	return b
+}
+
+//Filter adds a FILTER operation.
+func (b *AqlBuilder) Filter(expr string, args ...interface{}) *AqlBuilder {
+	return b.add("FILTER", expr, args)
+}
+
+//Let adds a LET variable = expr operation.
+func (b *AqlBuilder) Let(variable string, expr string, args ...interface{}) *AqlBuilder {
+	if b.err == nil && !aqlVariable.MatchString(variable) {
+		b.fail(fmt.Sprintf("%q is not a valid variable name.", variable))
+		return b
+	}
+	return b.add("LET "+variable+" =", expr, args)
+}
+
+//Collect adds a COLLECT operation, like Collect("city = u.city INTO g").
+func (b *AqlBuilder) Collect(expr string, args ...interface{}) *AqlBuilder {
+	return b.add("COLLECT", expr, args)
+}
+
+//Sort adds a SORT operation. Each field is an AQL expression
+//optionally followed by ASC or DESC.
+func (b *AqlBuilder) Sort(fields ...string) *AqlBuilder {
+	if len(fields) == 0 {
+		return b
+	}
+	return b.add("SORT", strings.Join(fields, ", "), nil)
+}
+
+//Limit adds a LIMIT count operation.
+func (b *AqlBuilder) Limit(count int) *AqlBuilder {
+	return b.add("LIMIT", fmt.Sprintf("%d", count), nil)
+}
+
+//LimitOffset adds a LIMIT offset, count operation.
+func (b *AqlBuilder) LimitOffset(offset, count int) *AqlBuilder {
+	return b.add("LIMIT", fmt.Sprintf("%d, %d", offset, count), nil)
+}
+
+//Return adds the RETURN operation.
+func (b *AqlBuilder) Return(expr string, args ...interface{}) *AqlBuilder {
+	return b.add("RETURN", expr, args)
+}
+
+//ReturnDistinct adds a RETURN DISTINCT operation.
+func (b *AqlBuilder) ReturnDistinct(expr string, args ...interface{}) *AqlBuilder {
+	return b.add("RETURN DISTINCT", expr, args)
+}
+
+//Query returns the AqlQuery that was built, ready to be passed
+//to Database.Query, or the first error made while building it.
+func (b *AqlBuilder) Query() (*AqlQuery, error) {
+	if b.err == nil && b.forVariable != "" {
+		b.fail("For must be followed by In.")
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.parts) == 0 {
+		return nil, newError("The query is empty.")
+	}
+
+	q := NewAqlQuery(strings.Join(b.parts, " "))
+	for name, value := range b.bindVars {
+		q.BindVars[name] = value
+	}
+	return q, nil
+}
+
+//String returns the query text built so far.
+func (b *AqlBuilder) String() string {
+	return strings.Join(b.parts, " ")
+}
+
+//ready reports whether another operation can be added.
+func (b *AqlBuilder) ready(operation string) bool {
+	if b.err != nil {
+		return false
+	}
+	if b.forVariable != "" {
+		b.fail(fmt.Sprintf("For must be followed by In before %s.", operation))
+		return false
+	}
+	return true
+}
+
+func (b *AqlBuilder) add(operation, expr string, args []interface{}) *AqlBuilder {
+	if !b.ready(operation) {
+		return b
+	}
+
+	expr, err := b.substitute(expr, args)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.parts = append(b.parts, operation+" "+expr)
+	return b
+}
+
+//substitute replaces every ? outside of string literals and
+//comments in expr with a bind parameter for the next argument.
+//?? is written as a single ?.
+func (b *AqlBuilder) substitute(expr string, args []interface{}) (string, error) {
+	var out strings.Builder
+	next := 0
+
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+
+		//copy string literals and comments as they are
+		var end int
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			end = literalEnd(expr, i)
+		case strings.HasPrefix(expr[i:], "//"):
+			end = strings.IndexByte(expr[i:], '\n')
+			if end < 0 {
+				//end the comment so it doesn't swallow the next operation
+				out.WriteString(expr[i:] + "\n")
+				i = len(expr)
+				continue
+			}
+			end += i + 1
+		case strings.HasPrefix(expr[i:], "/*"):
+			end = strings.Index(expr[i+2:], "*/")
+			if end < 0 {
+				end = len(expr)
+			} else {
+				end += i + 4
+			}
+		case c == '?' && strings.HasPrefix(expr[i:], "??"):
+			out.WriteByte('?')
+			i++
+			continue
+		case c == '?':
+			if next >= len(args) {
+				return "", newError(fmt.Sprintf("Not enough arguments for %q.", expr))
+			}
+			out.WriteString(b.bind(args[next]))
+			next++
+			continue
+		default:
+			out.WriteByte(c)
+			continue
+		}
+
+		out.WriteString(expr[i:end])
+		i = end - 1
+	}
+
+	if next != len(args) {
+		return "", newError(fmt.Sprintf("Too many arguments for %q.", expr))
+	}
+
+	return out.String(), nil
+}
+
+//literalEnd returns the index right after the string literal
+//or quoted name that starts at start.
+func literalEnd(expr string, start int) int {
+	quote := expr[start]
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return len(expr)
+}
+
+//bind returns the bind parameter, or the AQL text for an
+//AqlExpr, to use for value.
+func (b *AqlBuilder) bind(value interface{}) string {
+	switch v := value.(type) {
+	case AqlExpr:
+		return string(v)
+	case *Collection:
+		if v == nil {
+			b.fail("Can't bind a nil collection.")
+			return ""
+		}
+		return b.bindCollection(v.Name())
+	}
+
+	name := fmt.Sprintf("p%d", b.params)
+	b.params++
+	b.bindVars[name] = value
+	return "@" + name
+}
+
+//bindCollection binds the collection name once no
+//matter how often it's used.
+func (b *AqlBuilder) bindCollection(collection string) string {
+	if name, ok := b.collections[collection]; ok {
+		return "@@" + name
+	}
+
+	name := fmt.Sprintf("c%d", len(b.collections))
+	b.collections[collection] = name
+	b.bindVars["@"+name] = collection
+	return "@@" + name
+}
+
+func (b *AqlBuilder) fail(msg string) {
+	if b.err == nil {
+		b.err = newError(msg)
+	}
+}
@@ -0,0 +1,99 @@
+package arango
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAqlBuilder(t *testing.T) {
+
+	users := &Collection{json: &collectionResult{Name: "users"}}
+	addresses := &Collection{json: &collectionResult{Name: "addresses"}}
+
+	q, err := For("u").In(users).
+		Filter("u.age >= ? AND u.name != ?", 21, "x' || true").
+		For("f").In(AqlExpr("u.friends")).
+		Filter("f._id IN ?", []string{"users/1"}).
+		Let("city", "FIRST(FOR a IN ? FILTER a.user == u._key RETURN a.city)", addresses).
+		Sort("u.name", "u.age DESC").
+		LimitOffset(5, 10).
+		Return("{name: u.name, city: city, note: '?'}").
+		Query()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "FOR u IN @@c0 FILTER u.age >= @p0 AND u.name != @p1 " +
+		"FOR f IN u.friends FILTER f._id IN @p2 " +
+		"LET city = FIRST(FOR a IN @@c1 FILTER a.user == u._key RETURN a.city) " +
+		"SORT u.name, u.age DESC LIMIT 5, 10 RETURN {name: u.name, city: city, note: '?'}"
+
+	if q.Query != expected {
+		t.Fatalf("Expected the query\n%s\nbut got\n%s", expected, q.Query)
+	}
+
+	if fmt.Sprint(q.BindVars) != "map[@c0:users @c1:addresses p0:21 p1:x' || true p2:[users/1]]" {
+		t.Fatalf("Unexpected bind variables %v", q.BindVars)
+	}
+
+	q, err = For("d").In("docs").
+		Filter("d.other IN ?", AqlExpr("d.allowed")).
+		Collect("type = d.type INTO g").
+		ReturnDistinct("{type, count: LENGTH(g), docs: ?}", users).
+		Query()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q.Query != "FOR d IN @@c0 FILTER d.other IN d.allowed COLLECT type = d.type INTO g RETURN DISTINCT {type, count: LENGTH(g), docs: @@c1}" {
+		t.Fatalf("Unexpected query %s", q.Query)
+	}
+
+	if fmt.Sprint(q.BindVars) != "map[@c0:docs @c1:users]" {
+		t.Fatalf("Expected collections to be bound once each: %v", q.BindVars)
+	}
+
+	q, err = NewAqlBuilder().Let("x", "? + ?", 1, 2).Limit(1).Return("x").Query()
+
+	if err != nil || q.Query != "LET x = @p0 + @p1 LIMIT 1 RETURN x" {
+		t.Fatalf("Unexpected query %v %v", q, err)
+	}
+
+	q, err = For("u").In(users).
+		Filter("u.tags[?? ANY FILTER CURRENT == ?] /* any ? tag */", "admin").
+		Return("u.age >= ? ?? 'adult' : 'minor' // why?\n", 18).
+		Query()
+
+	expected = "FOR u IN @@c0 FILTER u.tags[? ANY FILTER CURRENT == @p0] /* any ? tag */ RETURN u.age >= @p1 ? 'adult' : 'minor' // why?\n"
+
+	if err != nil || q.Query != expected {
+		t.Fatalf("Expected ?? to become ? and comments to be left alone: %v %v", q, err)
+	}
+
+	q, err = For("u").In(users).Filter("u.active // only active ones?").Return("u").Query()
+
+	if err != nil || q.Query != "FOR u IN @@c0 FILTER u.active // only active ones?\n RETURN u" {
+		t.Fatalf("Expected a trailing comment to be ended before the next operation: %v %v", q, err)
+	}
+
+	broken := map[string]*AqlBuilder{
+		"missing argument": For("u").In("users").Filter("u.a == ? OR u.b == ?", 1),
+		"extra argument":   For("u").In("users").Return("u", 1),
+		"missing In":       For("u").Return("u"),
+		"dangling For":     For("u").In("users").For("v"),
+		"In without For":   NewAqlBuilder().In("users"),
+		"bad variable":     For("u; REMOVE").In("users"),
+		"bad let variable": NewAqlBuilder().Let("a b", "1"),
+		"nil collection":   For("u").In((*Collection)(nil)),
+		"empty collection": For("u").In(""),
+		"empty query":      NewAqlBuilder(),
+	}
+
+	for name, b := range broken {
+		if _, err = b.Query(); err == nil {
+			t.Fatalf("Expected an error for %s: %s", name, b)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package arango
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"strings"
+)
+
+//AqlFunction is an AQL user function. Name includes the
+//namespace, like myfunctions::temperature::celsiustofahrenheit.
+type AqlFunction struct {
+	Name string `json:"name"`
+
+	//Code is the javascript source of the function.
+	Code string `json:"code"`
+
+	//IsDeterministic tells the optimizer the function always
+	//returns the same result for the same arguments.
+	IsDeterministic bool `json:"isDeterministic"`
+}
+
+type aqlFunctionsResult struct {
+	Result []AqlFunction `json:"result"`
+}
+
+//AqlFunctions returns the AQL user functions in namespace.
+//An empty namespace returns all of them.
+func (db *Database) AqlFunctions(namespace string) ([]AqlFunction, error) {
+	return db.AqlFunctionsCtx(context.Background(), namespace)
+}
+
+//AqlFunctionsCtx is like AqlFunctions but the request is bound to ctx.
+func (db *Database) AqlFunctionsCtx(ctx context.Context, namespace string) ([]AqlFunction, error) {
+
+	var query url.Values = make(url.Values)
+	if namespace != "" {
+		query.Add("namespace", namespace)
+	}
+
+	var raw json.RawMessage
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/aqlfunction", db.serverUrl.String())
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, &query, &raw, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+	default:
+		return nil, e
+	}
+
+	//Older servers return the list itself instead of
+	//wrapping it in result
+	var functions []AqlFunction
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		err = json.Unmarshal(raw, &functions)
+	} else {
+		var result aqlFunctionsResult
+		err = json.Unmarshal(raw, &result)
+		functions = result.Result
+	}
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return functions, nil
+}
+
+//RegisterAqlFunction creates the AQL user function name or
+//replaces it if it already exists.
+func (db *Database) RegisterAqlFunction(name, code string, isDeterministic bool) error {
+	return db.RegisterAqlFunctionCtx(context.Background(), name, code, isDeterministic)
+}
+
+//RegisterAqlFunctionCtx is like RegisterAqlFunction but the request is bound to ctx.
+func (db *Database) RegisterAqlFunctionCtx(ctx context.Context, name, code string, isDeterministic bool) error {
+
+	if name == "" || code == "" {
+		return newError("You must provide a name and code when registering an aql function.")
+	}
+
+	payload := AqlFunction{
+		Name:            name,
+		Code:            code,
+		IsDeterministic: isDeterministic,
+	}
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/aqlfunction", db.serverUrl.String())
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, &payload, &struct{}{}, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200, 201:
+		return nil
+	default:
+		return e
+	}
+}
+
+//UnregisterAqlFunction removes the AQL user function name. If
+//group is true then name is a namespace and every function in
+//it is removed.
+func (db *Database) UnregisterAqlFunction(name string, group bool) error {
+	return db.UnregisterAqlFunctionCtx(context.Background(), name, group)
+}
+
+//UnregisterAqlFunctionCtx is like UnregisterAqlFunction but the request is bound to ctx.
+func (db *Database) UnregisterAqlFunctionCtx(ctx context.Context, name string, group bool) error {
+
+	if name == "" {
+		return newError("You must provide the name of the aql function to unregister.")
+	}
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/aqlfunction/%s?group=%t",
+		db.serverUrl.String(),
+		url.PathEscape(name),
+		group,
+	)
+	session := db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, nil, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
+
+//SyncAqlFunctions makes the AQL user functions in namespace match
+//functions. Functions that are missing or differ are registered
+//and the ones in namespace that aren't in functions are removed.
+//Every function must belong to namespace and appear only once.
+//Names are compared case insensitively, like arango does.
+func (db *Database) SyncAqlFunctions(namespace string, functions []AqlFunction) error {
+	return db.SyncAqlFunctionsCtx(context.Background(), namespace, functions)
+}
+
+//SyncAqlFunctionsCtx is like SyncAqlFunctions but the requests are bound to ctx.
+func (db *Database) SyncAqlFunctionsCtx(ctx context.Context, namespace string, functions []AqlFunction) error {
+
+	if namespace == "" {
+		return newError("You must provide a namespace when syncing aql functions.")
+	}
+
+	prefix := strings.ToLower(namespace) + "::"
+	wanted := make(map[string]AqlFunction, len(functions))
+
+	for _, f := range functions {
+		//arango treats function names case insensitively
+		name := strings.ToLower(f.Name)
+		if !strings.HasPrefix(name, prefix) {
+			return newError(fmt.Sprintf("The aql function %s is not in the namespace %s.", f.Name, namespace))
+		}
+		if _, ok := wanted[name]; ok {
+			return newError(fmt.Sprintf("The aql function %s is given more than once.", f.Name))
+		}
+		wanted[name] = f
+	}
+
+	existing, err := db.AqlFunctionsCtx(ctx, namespace)
+
+	if err != nil {
+		return err
+	}
+
+	for _, f := range existing {
+		name := strings.ToLower(f.Name)
+
+		//never touch functions outside of namespace
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if w, ok := wanted[name]; ok {
+			if w.Code == f.Code && w.IsDeterministic == f.IsDeterministic {
+				delete(wanted, name)
+			}
+			continue
+		}
+
+		if err = db.UnregisterAqlFunctionCtx(ctx, f.Name, false); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	for _, f := range functions {
+		if _, ok := wanted[strings.ToLower(f.Name)]; !ok {
+			continue
+		}
+		if err = db.RegisterAqlFunctionCtx(ctx, f.Name, f.Code, f.IsDeterministic); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//SyncAqlFunctionsDir is like SyncAqlFunctions but reads the
+//functions from the *.js files in dir and its subdirectories.
+//Every file holds the code of one function. Its path makes up
+//the name of the function inside namespace, so temperature/toF.js
+//becomes namespace::temperature::toF. Files ending in
+//.deterministic.js are registered as deterministic without that
+//suffix in their name. Use os.DirFS to read a directory on disk.
+func (db *Database) SyncAqlFunctionsDir(namespace string, dir fs.FS) error {
+	return db.SyncAqlFunctionsDirCtx(context.Background(), namespace, dir)
+}
+
+//SyncAqlFunctionsDirCtx is like SyncAqlFunctionsDir but the requests are bound to ctx.
+func (db *Database) SyncAqlFunctionsDirCtx(ctx context.Context, namespace string, dir fs.FS) error {
+
+	if dir == nil {
+		return newError("You must provide a directory when syncing aql functions.")
+	}
+
+	var functions []AqlFunction
+
+	err := fs.WalkDir(dir, ".", func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || path.Ext(file) != ".js" {
+			return nil
+		}
+
+		code, err := fs.ReadFile(dir, file)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(file, ".js")
+		deterministic := strings.HasSuffix(name, ".deterministic")
+		name = strings.TrimSuffix(name, ".deterministic")
+
+		functions = append(functions, AqlFunction{
+			Name:            namespace + "::" + strings.ReplaceAll(name, "/", "::"),
+			Code:            strings.TrimSpace(string(code)),
+			IsDeterministic: deterministic,
+		})
+		return nil
+	})
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return db.SyncAqlFunctionsCtx(ctx, namespace, functions)
+}
@@ -0,0 +1,143 @@
+package arango
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"testing/fstest"
+)
+
+func TestAqlFunctions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	err := db.RegisterAqlFunction("tests::double", "function (x) { return x * 2; }", true)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, err := db.Query(NewAqlQuery("RETURN tests::double(21)"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doubled int
+
+	if err = cur.Next(&doubled); err != nil || doubled != 42 {
+		t.Fatalf("Expected the user function to be called: %d %v", doubled, err)
+	}
+
+	err = db.SyncAqlFunctions("tests", []AqlFunction{
+		{Name: "tests::triple", Code: "function (x) { return x * 3; }", IsDeterministic: true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	functions, err := db.AqlFunctions("tests")
+
+	if err != nil || len(functions) != 1 || functions[0].Name != "tests::triple" {
+		t.Fatalf("Expected only the synced function to be left: %+v %v", functions, err)
+	}
+
+	if err = db.UnregisterAqlFunction("tests", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.UnregisterAqlFunction("tests::triple", false); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error after removing the namespace but got %v", err)
+	}
+}
+
+func TestAqlFunctionRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.URL.Query().Get("namespace") == "old" {
+				fmt.Fprint(w, `[{"name":"old::f","code":"function () {}","isDeterministic":false}]`)
+				return
+			}
+			fmt.Fprint(w, `{"error":false,"code":200,"result":[`+
+				`{"name":"ns::same","code":"function () { return 1; }","isDeterministic":true},`+
+				`{"name":"ns::changed","code":"function () { return 1; }","isDeterministic":false},`+
+				`{"name":"ns::stale","code":"function () {}","isDeterministic":false}]}`)
+		case "POST":
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"error":false,"code":201}`)
+		default:
+			fmt.Fprint(w, `{"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	functions, err := db.AqlFunctions("old")
+
+	if err != nil || len(functions) != 1 || functions[0].Name != "old::f" {
+		t.Fatalf("Expected the plain list of older servers to be decoded: %+v %v", functions, err)
+	}
+
+	if err = db.RegisterAqlFunction("ns::f", "", false); err == nil {
+		t.Fatal("Expected an error when registering a function without code.")
+	}
+
+	if err = db.SyncAqlFunctions("ns", []AqlFunction{{Name: "other::f", Code: "function () {}"}}); err == nil {
+		t.Fatal("Expected an error when syncing a function of another namespace.")
+	}
+
+	err = db.SyncAqlFunctions("ns", []AqlFunction{
+		{Name: "ns::same", Code: "function () { return 1; }", IsDeterministic: true},
+		{Name: "NS::Changed", Code: "function () { return 2; }"},
+		{Name: "ns::new", Code: "function () { return 3; }", IsDeterministic: true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.UnregisterAqlFunction("ns", true); err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.SyncAqlFunctions("ns", []AqlFunction{
+		{Name: "ns::twice", Code: "function () {}"},
+		{Name: "NS::Twice", Code: "function () { return 1; }"},
+	})
+
+	if err == nil {
+		t.Fatal("Expected an error when a function is given twice.")
+	}
+
+	err = db.SyncAqlFunctionsDir("ns", fstest.MapFS{
+		"same.deterministic.js": {Data: []byte("function () { return 1; }\n")},
+		"sub/triple.js":         {Data: []byte("function (x) { return x * 3; }")},
+		"README.md":             {Data: []byte("not a function")},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`GET /aqlfunction?namespace=old`,
+		`GET /aqlfunction?namespace=ns`,
+		`DELETE /aqlfunction/ns::stale?group=false`,
+		`POST /aqlfunction {"name":"NS::Changed","code":"function () { return 2; }","isDeterministic":false}`,
+		`POST /aqlfunction {"name":"ns::new","code":"function () { return 3; }","isDeterministic":true}`,
+		`DELETE /aqlfunction/ns?group=true`,
+		`GET /aqlfunction?namespace=ns`,
+		`DELETE /aqlfunction/ns::changed?group=false`,
+		`DELETE /aqlfunction/ns::stale?group=false`,
+		`POST /aqlfunction {"name":"ns::sub::triple","code":"function (x) { return x * 3; }","isDeterministic":false}`,
+	)
+}
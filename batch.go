@@ -0,0 +1,394 @@
+package arango
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+)
+
+//Batch queues several document operations and sends them to arango
+//in a single request using the POST /_api/batch endpoint.
+//Get one with db.Batch(), queue the operations and call Send.
+//
+//Each queue method returns a BatchOperation. Its Err field is set
+//once Send returns and the document passed in is populated just like
+//the regular methods would do it.
+//
+//A Batch is not safe for concurrent use.
+type Batch struct {
+	db         *Database
+	operations []*BatchOperation
+}
+
+//BatchOperation is one operation that is part of a Batch.
+type BatchOperation struct {
+	method string
+	path   string
+	header http.Header
+	body   interface{}
+	result interface{}
+
+	//Err is nil if the operation succeeded. Otherwise it is an ArangoError.
+	//It is only set after Batch.Send returns.
+	Err error
+}
+
+//Batch returns a new empty batch of operations for this database.
+func (db *Database) Batch() *Batch {
+	return &Batch{db: db}
+}
+
+//Len returns the number of operations queued.
+func (b *Batch) Len() int {
+	return len(b.operations)
+}
+
+func (b *Batch) queue(method, path string, header http.Header, body, result interface{}) *BatchOperation {
+	op := &BatchOperation{
+		method: method,
+		path:   path,
+		header: header,
+		body:   body,
+		result: result,
+	}
+	b.operations = append(b.operations, op)
+	return op
+}
+
+//failed queues an operation that can't be sent because its
+//arguments were invalid. Send leaves it out of the request.
+func (b *Batch) failed(err error) *BatchOperation {
+	op := &BatchOperation{Err: err}
+	b.operations = append(b.operations, op)
+	return op
+}
+
+//SaveDocument queues a document save like db.SaveDocumentWithOptions.
+func (b *Batch) SaveDocument(document interface{}, options *SaveOptions) *BatchOperation {
+
+	if options == nil || options.Collection == "" {
+		return b.failed(newError("You must provide a collection name in the options when using batch.SaveDocument."))
+	}
+
+	var values url.Values = make(url.Values)
+	values.Add("collection", options.Collection)
+	values.Add("createCollection", fmt.Sprintf("%t", options.CreateCollection))
+	values.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+
+	return b.queue("POST", "/_api/document?"+values.Encode(), nil, document, document)
+}
+
+//Document queues a document fetch like db.DocumentWithOptions.
+//options can be nil.
+func (b *Batch) Document(documentHandle, document interface{}, options *GetOptions) *BatchOperation {
+
+	id, rev, err := resolveHandle(documentHandle)
+
+	if err != nil {
+		return b.failed(err)
+	}
+
+	var header = make(http.Header)
+	if options != nil {
+		if options.IfNoneMatch != "" {
+			header.Set("If-None-Match", options.IfNoneMatch)
+		}
+		if options.IfMatch != "" {
+			header.Set("If-Match", options.IfMatch)
+		}
+	}
+	if rev != "" {
+		header.Set("If-Match", rev)
+	}
+
+	return b.queue("GET", "/_api/document/"+id, header, nil, document)
+}
+
+//ReplaceDocument queues a document replacement like db.ReplaceDocumentWithOptions.
+//options can be nil.
+func (b *Batch) ReplaceDocument(documentHandle, document interface{}, options *ReplaceOptions) *BatchOperation {
+
+	id, rev, err := resolveHandle(documentHandle)
+
+	if err != nil {
+		return b.failed(err)
+	}
+
+	var header = make(http.Header)
+	var query url.Values = make(url.Values)
+
+	if options != nil {
+		if options.IfMatch != "" {
+			header.Set("If-Match", options.IfMatch)
+		}
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		if options.Rev != "" {
+			query.Add("rev", options.Rev)
+		}
+		if options.Policy != "" {
+			query.Add("policy", options.Policy)
+		}
+	}
+	if rev != "" {
+		header.Set("If-Match", rev)
+	}
+
+	return b.queue("PUT", "/_api/document/"+id+"?"+query.Encode(), header, document, document)
+}
+
+//UpdateDocument queues a partial document update like db.UpdateDocumentWithOptions.
+//options can be nil.
+func (b *Batch) UpdateDocument(documentHandle, document interface{}, options *UpdateOptions) *BatchOperation {
+
+	id, rev, err := resolveHandle(documentHandle)
+
+	if err != nil {
+		return b.failed(err)
+	}
+
+	var header = make(http.Header)
+	var query url.Values = make(url.Values)
+
+	if options != nil {
+		if options.IfMatch != "" {
+			header.Set("If-Match", options.IfMatch)
+		}
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		query.Add("keepNull", fmt.Sprintf("%t", options.KeepNull))
+		query.Add("mergeArrays", fmt.Sprintf("%t", options.MergeArrays))
+		if options.Rev != "" {
+			query.Add("rev", options.Rev)
+		}
+		if options.Policy != "" {
+			query.Add("policy", options.Policy)
+		}
+	}
+	if rev != "" {
+		header.Set("If-Match", rev)
+	}
+
+	return b.queue("PATCH", "/_api/document/"+id+"?"+query.Encode(), header, document, document)
+}
+
+//DeleteDocument queues a document removal like db.DeleteDocumentWithOptions.
+//options can be nil.
+func (b *Batch) DeleteDocument(documentHandle interface{}, options *DeleteOptions) *BatchOperation {
+
+	id, rev, err := resolveHandle(documentHandle)
+
+	if err != nil {
+		return b.failed(err)
+	}
+
+	var header = make(http.Header)
+	var query url.Values = make(url.Values)
+
+	if options != nil {
+		if options.IfMatch != "" {
+			header.Set("If-Match", options.IfMatch)
+		}
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		if options.Rev != "" {
+			query.Add("rev", options.Rev)
+		}
+		if options.Policy != "" {
+			query.Add("policy", options.Policy)
+		}
+	}
+	if rev != "" {
+		header.Set("If-Match", rev)
+	}
+
+	return b.queue("DELETE", "/_api/document/"+id+"?"+query.Encode(), header, nil, nil)
+}
+
+//resolveHandle returns the id and revision of a document handle
+//the same way the document methods of Database do.
+func resolveHandle(documentHandle interface{}) (id, rev string, err error) {
+
+	switch dh := documentHandle.(type) {
+	case string:
+		id = dh
+	case HasArangoId:
+		id = dh.Id()
+	default:
+		return "", "", newError("The document handle you passed in is not valid.")
+	}
+
+	if id == "" {
+		return "", "", newError("You must specify a documentHandle.")
+	}
+
+	if r, ok := documentHandle.(HasArangoRev); ok {
+		rev = r.Rev()
+	}
+
+	return id, rev, nil
+}
+
+//Send sends all queued operations in one request. The returned error
+//is only about the batch request itself. Check the Err field of each
+//BatchOperation to see how the operations fared.
+//
+//Arango requires batch requests to be multipart/form-data encoded
+//with every part being an application/x-arango-batchpart.
+func (b *Batch) Send() error {
+	return b.SendCtx(context.Background())
+}
+
+//SendCtx is like Send but the request is bound to ctx.
+func (b *Batch) SendCtx(ctx context.Context) error {
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	pending := make(map[string]*BatchOperation)
+
+	for i, op := range b.operations {
+		if op.method == "" {
+			continue
+		}
+
+		contentId := strconv.Itoa(i + 1)
+
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {"application/x-arango-batchpart"},
+			"Content-Id":   {contentId},
+		})
+
+		if err != nil {
+			return wrapError(err)
+		}
+
+		var data []byte
+		if op.body != nil {
+			if data, err = json.Marshal(op.body); err != nil {
+				return wrapError(err)
+			}
+		}
+
+		fmt.Fprintf(part, "%s %s HTTP/1.1\r\n", op.method, op.path)
+		for k, v := range op.header {
+			for _, value := range v {
+				fmt.Fprintf(part, "%s: %s\r\n", k, value)
+			}
+		}
+		if data != nil {
+			fmt.Fprintf(part, "Content-Length: %d\r\n", len(data))
+		}
+		fmt.Fprint(part, "\r\n")
+		part.Write(data)
+
+		pending[contentId] = op
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := writer.Close(); err != nil {
+		return wrapError(err)
+	}
+
+	endpoint := fmt.Sprintf("%s/batch", b.db.serverUrl.String())
+
+	var header = make(http.Header)
+	header.Set("Content-Type", "multipart/form-data; boundary="+writer.Boundary())
+
+	response, data, err := b.db.doRaw(ctx, "POST", endpoint, header, &body)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	if response.StatusCode != 200 {
+		return batchError(response, data)
+	}
+
+	_, params, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+
+	boundary := params["boundary"]
+	if err != nil || boundary == "" {
+		boundary = writer.Boundary()
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(data), boundary)
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return wrapError(err)
+		}
+
+		op, ok := pending[part.Header.Get("Content-Id")]
+		if !ok {
+			continue
+		}
+		delete(pending, part.Header.Get("Content-Id"))
+
+		op.Err = op.decode(part)
+	}
+
+	for _, op := range pending {
+		op.Err = newError("Arango did not return a response for this batch operation.")
+	}
+
+	return nil
+}
+
+//decode reads the http response held in one part of the batch response.
+func (op *BatchOperation) decode(part io.Reader) error {
+
+	response, err := http.ReadResponse(bufio.NewReader(part), nil)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 || response.StatusCode == 304 {
+		if op.result != nil && len(data) > 0 {
+			if err := json.Unmarshal(data, op.result); err != nil {
+				return wrapError(err)
+			}
+		}
+		return nil
+	}
+
+	return batchError(response, data)
+}
+
+//batchError turns a failed response into an ArangoError, falling back
+//to the http status when arango didn't send an error body.
+func batchError(response *http.Response, data []byte) error {
+
+	var e ArangoError
+	if len(data) > 0 {
+		json.Unmarshal(data, &e)
+	}
+	if e.Code == 0 {
+		e.IsError = true
+		e.Code = response.StatusCode
+		e.ErrorMessage = response.Status
+	}
+	return e
+}
@@ -0,0 +1,166 @@
+package arango
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("batch")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := &DummyFullDocument{Hi: "existing"}
+
+	if err = c.Save(existing); err != nil {
+		t.Fatal(err)
+	}
+
+	batch := db.Batch()
+
+	saved := &DummyFullDocument{Hi: "saved"}
+	save := batch.SaveDocument(saved, &SaveOptions{Collection: "batch"})
+
+	fetched := &DummyFullDocument{}
+	fetch := batch.Document(existing.Id(), fetched, nil)
+
+	missing := batch.DeleteDocument("batch/doesnotexist", nil)
+
+	if err = batch.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	if save.Err != nil || saved.Id() == "" {
+		t.Fatalf("Expected the save to succeed and set the id: %v", save.Err)
+	}
+
+	if fetch.Err != nil || fetched.Hi != "existing" {
+		t.Fatalf("Expected the fetch to succeed: %v %+v", fetch.Err, fetched)
+	}
+
+	if !IsNotFound(missing.Err) {
+		t.Fatalf("Expected a not found error for the missing document but got %v", missing.Err)
+	}
+}
+
+func TestBatchRequest(t *testing.T) {
+
+	rec := newRequestRecorder("", "If-Match")
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_db/_system/_api/batch" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			return
+		}
+
+		mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+		if mediaType != "multipart/form-data" {
+			t.Errorf("Unexpected content type %s", mediaType)
+		}
+
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		writer := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", "multipart/form-data; boundary="+writer.Boundary())
+
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if part.Header.Get("Content-Type") != "application/x-arango-batchpart" {
+				t.Errorf("Unexpected part content type %s", part.Header.Get("Content-Type"))
+			}
+
+			req, err := http.ReadRequest(bufio.NewReader(part))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			body, _ := io.ReadAll(req.Body)
+			rec.record(req, body)
+
+			out, _ := writer.CreatePart(map[string][]string{
+				"Content-Type": {"application/x-arango-batchpart"},
+				"Content-Id":   {part.Header.Get("Content-Id")},
+			})
+
+			switch req.Method {
+			case "POST":
+				fmt.Fprint(out, "HTTP/1.1 202 Accepted\r\nContent-Type: application/json\r\n\r\n"+`{"_id":"test/1","_rev":"10","_key":"1"}`)
+			case "GET":
+				fmt.Fprint(out, "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"+`{"_id":"test/2","_rev":"20","_key":"2","Hi":"there"}`)
+			default:
+				fmt.Fprint(out, "HTTP/1.1 404 Not Found\r\nContent-Type: application/json\r\n\r\n"+`{"error":true,"code":404,"errorNum":1202,"errorMessage":"document not found"}`)
+			}
+		}
+		writer.Close()
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch := db.Batch()
+
+	saved := &DummyFullDocument{Hi: "saved"}
+	save := batch.SaveDocument(saved, &SaveOptions{Collection: "test"})
+
+	fetched := &DummyFullDocument{}
+	fetch := batch.Document("test/2", fetched, nil)
+
+	invalid := batch.ReplaceDocument("", &DummyDocument{}, nil)
+
+	removed := &DummyFullDocument{}
+	removed.SetId("test/3")
+	removed.SetRev("30")
+	remove := batch.DeleteDocument(removed, &DeleteOptions{WaitForSync: true})
+
+	if batch.Len() != 4 {
+		t.Fatalf("Expected 4 queued operations but got %d", batch.Len())
+	}
+
+	if err = batch.Send(); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /_api/document?collection=test&createCollection=false&waitForSync=false {"Hi":"saved"}`,
+		`GET /_api/document/test/2`,
+		`DELETE /_api/document/test/3?waitForSync=true If-Match:30`,
+	)
+
+	if save.Err != nil || saved.Id() != "test/1" || saved.Rev() != "10" {
+		t.Fatalf("Expected the saved document to be updated: %v %+v", save.Err, saved)
+	}
+
+	if fetch.Err != nil || fetched.Hi != "there" || fetched.Key() != "2" {
+		t.Fatalf("Expected the fetched document to be filled in: %v %+v", fetch.Err, fetched)
+	}
+
+	if invalid.Err == nil {
+		t.Fatal("Expected the operation without a handle to fail without being sent.")
+	}
+
+	if !IsNotFound(remove.Err) || remove.Err.(ArangoError).ErrorNum != ERROR_ARANGO_DOCUMENT_NOT_FOUND {
+		t.Fatalf("Expected a document not found error but got %v", remove.Err)
+	}
+}
@@ -1,8 +1,10 @@
 package arango
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 //Collection types
@@ -27,8 +29,13 @@ const (
 //Collection represents a collection from arangodb
 //Don't instantiate this yourself. Use db.Collection
 //to get the one you want.
+//
+//A Collection is safe for concurrent use by multiple goroutines.
+//The cached properties are swapped out as a whole whenever they
+//are refreshed so readers never see a half updated value.
 type Collection struct {
 	db   *Database
+	mu   sync.RWMutex
 	json *collectionResult
 }
 
@@ -86,9 +93,28 @@ type collectionResult struct {
 	ShardKeys      []string
 	KeyOptions     *KeyOptions
 
+	//Only populated by the admin calls that return them
+	Count    int64
+	Revision string
+	Figures  *CollectionFigures
+
 	ArangoError
 }
 
+//result returns the cached collection information.
+func (c *Collection) result() *collectionResult {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.json
+}
+
+//setResult replaces the cached collection information.
+func (c *Collection) setResult(result *collectionResult) {
+	c.mu.Lock()
+	c.json = result
+	c.mu.Unlock()
+}
+
 //DefaultCollectionOptions creates a default set of collection options
 //If you will always be using the defaults then just use the Create
 //method as it uses the defaults.
@@ -100,12 +126,12 @@ func DefaultCollectionOptions() CollectionCreationOptions {
 
 //Id returns the id of the collection.
 func (c *Collection) Id() string {
-	return c.json.Id
+	return c.result().Id
 }
 
 //Name returns the name of the collection.
 func (c *Collection) Name() string {
-	return c.json.Name
+	return c.result().Name
 }
 
 //Status returns the status of the collection.
@@ -119,7 +145,7 @@ func (c *Collection) Name() string {
 //DELETED_STATUS        = 5
 //)
 func (c *Collection) Status() int {
-	return c.json.Status
+	return c.result().Status
 }
 
 //Type returns the type of the collection.
@@ -128,48 +154,48 @@ func (c *Collection) Status() int {
 //  DOCUMENT_COLLECTION = 2
 //  EDGE_COLLECTION     = 3
 func (c *Collection) Type() int {
-	return c.json.Type
+	return c.result().Type
 }
 
 //IsSystem returns whether the collection is a system collection or not.
 //System collections typically start with an underscore like _system
 func (c *Collection) IsSystem() bool {
-	return c.json.IsSystem
+	return c.result().IsSystem
 }
 
 //WaitForSync will only have an accurate answer if you call c.Properties first
 func (c *Collection) WaitForSync() bool {
-	return c.json.WaitForSync
+	return c.result().WaitForSync
 }
 
 //DoCompact will only have an accurate answer if you call c.Properties first
 func (c *Collection) DoCompact() bool {
-	return c.json.DoCompact
+	return c.result().DoCompact
 }
 
 //JournalSize will only have an accurate answer if you call c.Properties first
 func (c *Collection) JournalSize() int {
-	return c.json.JournalSize
+	return c.result().JournalSize
 }
 
 //IsVolatile will only have an accurate answer if you call c.Properties first
 func (c *Collection) IsVolatile() bool {
-	return c.json.IsVolatile
+	return c.result().IsVolatile
 }
 
 //NumberOfShards will only have an accurate answer if you call c.Properties first
 func (c *Collection) NumberOfShards() int {
-	return c.json.NumberOfShards
+	return c.result().NumberOfShards
 }
 
 //ShardKeys will only have an accurate answer if you call c.Properties first
 func (c *Collection) ShardKeys() []string {
-	return c.json.ShardKeys
+	return c.result().ShardKeys
 }
 
 //KeyOptions will only have an accurate answer if you call c.Properties first
 func (c *Collection) KeyOptions() *KeyOptions {
-	return c.json.KeyOptions
+	return c.result().KeyOptions
 }
 
 //Properties fetches additional properties of the collection.
@@ -179,21 +205,29 @@ func (c *Collection) KeyOptions() *KeyOptions {
 //Use this especially if you want to update some of the properties
 //like the Status().
 func (c *Collection) Properties() error {
+	return c.PropertiesCtx(context.Background())
+}
+
+//PropertiesCtx is like Properties but the request is bound to ctx.
+func (c *Collection) PropertiesCtx(ctx context.Context) error {
 
 	db := c.db
 
+	var result = new(collectionResult)
 	var e ArangoError
 
 	endpoint := fmt.Sprintf("%s/collection/%s/properties", db.serverUrl.String(), c.Name())
 
-	response, err := db.session.Get(endpoint, nil, c.json, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, nil, result, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
 	case 200:
+		c.setResult(result)
 		return nil
 	default:
 		return e
@@ -206,7 +240,12 @@ func (c *Collection) Properties() error {
 //Calling any further methods on it will result in
 //unexpected behavior
 func (c *Collection) Drop() error {
-	return c.db.DropCollection(c.Name())
+	return c.DropCtx(context.Background())
+}
+
+//DropCtx is like Drop but the request is bound to ctx.
+func (c *Collection) DropCtx(ctx context.Context) error {
+	return c.db.DropCollectionCtx(ctx, c.Name())
 }
 
 //Save creates a document in the collection.
@@ -216,7 +255,12 @@ func (c *Collection) Drop() error {
 //from arango, then it will be populated with the Id, Rev, Key
 //fields during the json.Unmarshal call
 func (c *Collection) Save(document interface{}) error {
-	return c.db.SaveDocumentWithOptions(document, &SaveOptions{
+	return c.SaveCtx(context.Background(), document)
+}
+
+//SaveCtx is like Save but the request is bound to ctx.
+func (c *Collection) SaveCtx(ctx context.Context, document interface{}) error {
+	return c.db.SaveDocumentWithOptionsCtx(ctx, document, &SaveOptions{
 		Collection:       c.Name(),
 		CreateCollection: false,
 		WaitForSync:      false,
@@ -226,17 +270,34 @@ func (c *Collection) Save(document interface{}) error {
 //SaveWithOptions lets you save a document but lets you specify some options
 //See the POST /_api/document endpoint for more info.
 func (c *Collection) SaveWithOptions(document interface{}, options *SaveOptions) error {
+	return c.SaveWithOptionsCtx(context.Background(), document, options)
+}
+
+//SaveWithOptionsCtx is like SaveWithOptions but the request is bound to ctx.
+func (c *Collection) SaveWithOptionsCtx(ctx context.Context, document interface{}, options *SaveOptions) error {
+
+	//work on a copy so options shared between goroutines are left alone
+	var copied SaveOptions
+	if options != nil {
+		copied = *options
+	}
+	options = &copied
 
 	options.Collection = c.Name()
 	options.CreateCollection = false
 
-	return c.db.SaveDocumentWithOptions(document, options)
+	return c.db.SaveDocumentWithOptionsCtx(ctx, document, options)
 }
 
 //SaveEdge creates a new edge using pointing from "from" to "to".
 //Will probably result in an error if arango determines that this collection is not an edge collection.
 func (c *Collection) SaveEdge(from, to, edge interface{}) error {
-	return c.db.SaveEdgeWithOptions(from, to, edge, &SaveOptions{
+	return c.SaveEdgeCtx(context.Background(), from, to, edge)
+}
+
+//SaveEdgeCtx is like SaveEdge but the request is bound to ctx.
+func (c *Collection) SaveEdgeCtx(ctx context.Context, from, to, edge interface{}) error {
+	return c.db.SaveEdgeWithOptionsCtx(ctx, from, to, edge, &SaveOptions{
 		Collection:       c.Name(),
 		CreateCollection: false,
 		WaitForSync:      false,
@@ -246,10 +307,23 @@ func (c *Collection) SaveEdge(from, to, edge interface{}) error {
 //SaveEdgeWithOptions creates a new edge using pointing from "from" to "to" and allows you to specify more options.
 //Will probably result in an error if arango determines that this collection is not an edge collection.
 func (c *Collection) SaveEdgeWithOptions(from, to, edge interface{}, options *SaveOptions) error {
+	return c.SaveEdgeWithOptionsCtx(context.Background(), from, to, edge, options)
+}
+
+//SaveEdgeWithOptionsCtx is like SaveEdgeWithOptions but the request is bound to ctx.
+func (c *Collection) SaveEdgeWithOptionsCtx(ctx context.Context, from, to, edge interface{}, options *SaveOptions) error {
+
+	//work on a copy so options shared between goroutines are left alone
+	var copied SaveOptions
+	if options != nil {
+		copied = *options
+	}
+	options = &copied
+
 	options.Collection = c.Name()
 	options.CreateCollection = false
 
-	return c.db.SaveEdgeWithOptions(from, to, edge, options)
+	return c.db.SaveEdgeWithOptionsCtx(ctx, from, to, edge, options)
 }
 
 //Document will fetch the document associated with the documentHandle.
@@ -264,16 +338,29 @@ func (c *Collection) SaveEdgeWithOptions(from, to, edge interface{}, options *Sa
 //If you want to look up an arbitrary document then use db.Document()
 func (c *Collection) Document(documentHandle interface{},
 	document interface{}) error {
-	return c.DocumentWithOptions(documentHandle, document, nil)
+	return c.DocumentCtx(context.Background(), documentHandle, document)
+}
+
+//DocumentCtx is like Document but the request is bound to ctx.
+func (c *Collection) DocumentCtx(ctx context.Context, documentHandle interface{},
+	document interface{}) error {
+	return c.DocumentWithOptionsCtx(ctx, documentHandle, document, nil)
 }
 
 func (c *Collection) DocumentWithOptions(documentHandle interface{},
 	document interface{},
 	options *GetOptions) error {
+	return c.DocumentWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//DocumentWithOptionsCtx is like DocumentWithOptions but the request is bound to ctx.
+func (c *Collection) DocumentWithOptionsCtx(ctx context.Context, documentHandle interface{},
+	document interface{},
+	options *GetOptions) error {
 
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.DocumentWithOptions(documentHandle, document, options)
+		return c.db.DocumentWithOptionsCtx(ctx, documentHandle, document, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
@@ -281,16 +368,29 @@ func (c *Collection) DocumentWithOptions(documentHandle interface{},
 
 func (c *Collection) Edge(documentHandle interface{},
 	edge interface{}) error {
-	return c.EdgeWithOptions(documentHandle, edge, nil)
+	return c.EdgeCtx(context.Background(), documentHandle, edge)
+}
+
+//EdgeCtx is like Edge but the request is bound to ctx.
+func (c *Collection) EdgeCtx(ctx context.Context, documentHandle interface{},
+	edge interface{}) error {
+	return c.EdgeWithOptionsCtx(ctx, documentHandle, edge, nil)
 }
 
 func (c *Collection) EdgeWithOptions(documentHandle interface{},
 	edge interface{},
 	options *GetOptions) error {
+	return c.EdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//EdgeWithOptionsCtx is like EdgeWithOptions but the request is bound to ctx.
+func (c *Collection) EdgeWithOptionsCtx(ctx context.Context, documentHandle interface{},
+	edge interface{},
+	options *GetOptions) error {
 
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.EdgeWithOptions(documentHandle, edge, options)
+		return c.db.EdgeWithOptionsCtx(ctx, documentHandle, edge, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
@@ -298,15 +398,28 @@ func (c *Collection) EdgeWithOptions(documentHandle interface{},
 
 func (c *Collection) Replace(documentHandle interface{},
 	document interface{}) error {
-	return c.ReplaceWithOptions(documentHandle, document, nil)
+	return c.ReplaceCtx(context.Background(), documentHandle, document)
+}
+
+//ReplaceCtx is like Replace but the request is bound to ctx.
+func (c *Collection) ReplaceCtx(ctx context.Context, documentHandle interface{},
+	document interface{}) error {
+	return c.ReplaceWithOptionsCtx(ctx, documentHandle, document, nil)
 }
 
 func (c *Collection) ReplaceWithOptions(documentHandle interface{},
+	document interface{},
+	options *ReplaceOptions) error {
+	return c.ReplaceWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//ReplaceWithOptionsCtx is like ReplaceWithOptions but the request is bound to ctx.
+func (c *Collection) ReplaceWithOptionsCtx(ctx context.Context, documentHandle interface{},
 	document interface{},
 	options *ReplaceOptions) error {
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.ReplaceDocumentWithOptions(documentHandle, document, options)
+		return c.db.ReplaceDocumentWithOptionsCtx(ctx, documentHandle, document, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
@@ -314,15 +427,28 @@ func (c *Collection) ReplaceWithOptions(documentHandle interface{},
 
 func (c *Collection) ReplaceEdge(documentHandle interface{},
 	edge interface{}) error {
-	return c.ReplaceEdgeWithOptions(documentHandle, edge, nil)
+	return c.ReplaceEdgeCtx(context.Background(), documentHandle, edge)
+}
+
+//ReplaceEdgeCtx is like ReplaceEdge but the request is bound to ctx.
+func (c *Collection) ReplaceEdgeCtx(ctx context.Context, documentHandle interface{},
+	edge interface{}) error {
+	return c.ReplaceEdgeWithOptionsCtx(ctx, documentHandle, edge, nil)
 }
 
 func (c *Collection) ReplaceEdgeWithOptions(documentHandle interface{},
+	edge interface{},
+	options *ReplaceOptions) error {
+	return c.ReplaceEdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//ReplaceEdgeWithOptionsCtx is like ReplaceEdgeWithOptions but the request is bound to ctx.
+func (c *Collection) ReplaceEdgeWithOptionsCtx(ctx context.Context, documentHandle interface{},
 	edge interface{},
 	options *ReplaceOptions) error {
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.ReplaceEdgeWithOptions(documentHandle, edge, options)
+		return c.db.ReplaceEdgeWithOptionsCtx(ctx, documentHandle, edge, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
@@ -330,15 +456,28 @@ func (c *Collection) ReplaceEdgeWithOptions(documentHandle interface{},
 
 func (c *Collection) Update(documentHandle interface{},
 	document interface{}) error {
-	return c.UpdateWithOptions(documentHandle, document, nil)
+	return c.UpdateCtx(context.Background(), documentHandle, document)
+}
+
+//UpdateCtx is like Update but the request is bound to ctx.
+func (c *Collection) UpdateCtx(ctx context.Context, documentHandle interface{},
+	document interface{}) error {
+	return c.UpdateWithOptionsCtx(ctx, documentHandle, document, nil)
 }
 
 func (c *Collection) UpdateWithOptions(documentHandle interface{},
+	document interface{},
+	options *UpdateOptions) error {
+	return c.UpdateWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//UpdateWithOptionsCtx is like UpdateWithOptions but the request is bound to ctx.
+func (c *Collection) UpdateWithOptionsCtx(ctx context.Context, documentHandle interface{},
 	document interface{},
 	options *UpdateOptions) error {
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.UpdateDocumentWithOptions(documentHandle, document, options)
+		return c.db.UpdateDocumentWithOptionsCtx(ctx, documentHandle, document, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
@@ -346,44 +485,279 @@ func (c *Collection) UpdateWithOptions(documentHandle interface{},
 
 func (c *Collection) UpdateEdge(documentHandle interface{},
 	edge interface{}) error {
-	return c.UpdateEdgeWithOptions(documentHandle, edge, nil)
+	return c.UpdateEdgeCtx(context.Background(), documentHandle, edge)
+}
+
+//UpdateEdgeCtx is like UpdateEdge but the request is bound to ctx.
+func (c *Collection) UpdateEdgeCtx(ctx context.Context, documentHandle interface{},
+	edge interface{}) error {
+	return c.UpdateEdgeWithOptionsCtx(ctx, documentHandle, edge, nil)
 }
 
 func (c *Collection) UpdateEdgeWithOptions(documentHandle interface{},
+	edge interface{},
+	options *UpdateOptions) error {
+	return c.UpdateEdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//UpdateEdgeWithOptionsCtx is like UpdateEdgeWithOptions but the request is bound to ctx.
+func (c *Collection) UpdateEdgeWithOptionsCtx(ctx context.Context, documentHandle interface{},
 	edge interface{},
 	options *UpdateOptions) error {
 	documentHandle, ok := c.crossCollectionCheck(documentHandle)
 	if ok {
-		return c.db.UpdateDocumentWithOptions(documentHandle, edge, options)
+		return c.db.UpdateEdgeWithOptionsCtx(ctx, documentHandle, edge, options)
 	} else {
 		return newError(fmt.Sprintf("Cross collection requests are not permitted.", documentHandle, c.Name()))
 	}
 }
 
+//Delete removes the document associated with the documentHandle.
+//The documentHandle can be a key, a full id or anything implementing
+//HasArangoId or HasArangoKey. If it also implements HasArangoRev then
+//the document is only removed if its revision still matches.
+//Like Document, it won't let you delete a document that belongs to
+//another collection.
+//This uses the DELETE /_api/document/{document-handle} endpoint.
+func (c *Collection) Delete(documentHandle interface{}) error {
+	return c.DeleteCtx(context.Background(), documentHandle)
+}
+
+//DeleteCtx is like Delete but the request is bound to ctx.
+func (c *Collection) DeleteCtx(ctx context.Context, documentHandle interface{}) error {
+	return c.DeleteWithOptionsCtx(ctx, documentHandle, nil)
+}
+
+//DeleteWithOptions is like Delete but lets you specify the revision,
+//policy and waitForSync options.
+func (c *Collection) DeleteWithOptions(documentHandle interface{},
+	options *DeleteOptions) error {
+	return c.DeleteWithOptionsCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteWithOptionsCtx is like DeleteWithOptions but the request is bound to ctx.
+func (c *Collection) DeleteWithOptionsCtx(ctx context.Context, documentHandle interface{},
+	options *DeleteOptions) error {
+	documentHandle, ok := c.crossCollectionCheck(documentHandle)
+	if ok {
+		return c.db.DeleteDocumentWithOptionsCtx(ctx, documentHandle, options)
+	} else {
+		return newError(fmt.Sprintf("Cross collection requests are not permitted. (%s)", c.Name()))
+	}
+}
+
+//DeleteEdge removes the edge associated with the documentHandle.
+//It accepts the same handles as Delete.
+//This uses the DELETE /_api/edge/{document-handle} endpoint.
+func (c *Collection) DeleteEdge(documentHandle interface{}) error {
+	return c.DeleteEdgeCtx(context.Background(), documentHandle)
+}
+
+//DeleteEdgeCtx is like DeleteEdge but the request is bound to ctx.
+func (c *Collection) DeleteEdgeCtx(ctx context.Context, documentHandle interface{}) error {
+	return c.DeleteEdgeWithOptionsCtx(ctx, documentHandle, nil)
+}
+
+//DeleteEdgeWithOptions is like DeleteEdge but lets you specify the
+//revision, policy and waitForSync options.
+func (c *Collection) DeleteEdgeWithOptions(documentHandle interface{},
+	options *DeleteOptions) error {
+	return c.DeleteEdgeWithOptionsCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteEdgeWithOptionsCtx is like DeleteEdgeWithOptions but the request is bound to ctx.
+func (c *Collection) DeleteEdgeWithOptionsCtx(ctx context.Context, documentHandle interface{},
+	options *DeleteOptions) error {
+	documentHandle, ok := c.crossCollectionCheck(documentHandle)
+	if ok {
+		return c.db.DeleteEdgeWithOptionsCtx(ctx, documentHandle, options)
+	} else {
+		return newError(fmt.Sprintf("Cross collection requests are not permitted. (%s)", c.Name()))
+	}
+}
+
+//Edges fetches the edges of this edge collection that start or end
+//at vertex. See db.Edges for what vertex, direction, edges and
+//options can be.
+func (c *Collection) Edges(vertex interface{}, direction string, edges interface{}, options *EdgesOptions) error {
+	return c.EdgesCtx(context.Background(), vertex, direction, edges, options)
+}
+
+//EdgesCtx is like Edges but the request is bound to ctx.
+func (c *Collection) EdgesCtx(ctx context.Context, vertex interface{}, direction string, edges interface{}, options *EdgesOptions) error {
+	return c.db.EdgesCtx(ctx, c.Name(), vertex, direction, edges, options)
+}
+
 func (c *Collection) ByExample(example interface{}) (*Cursor, error) {
-	return c.db.ByExampleQuery(&ByExampleQuery{
+	return c.ByExampleCtx(context.Background(), example)
+}
+
+//ByExampleCtx is like ByExample but the request is bound to ctx.
+func (c *Collection) ByExampleCtx(ctx context.Context, example interface{}) (*Cursor, error) {
+	return c.db.ByExampleQueryCtx(ctx, &ByExampleQuery{
 		Collection: c.Name(),
 		Example:    example,
 	})
 }
 
 func (c *Collection) ByExampleQuery(query *ByExampleQuery) (*Cursor, error) {
+	return c.ByExampleQueryCtx(context.Background(), query)
+}
+
+//ByExampleQueryCtx is like ByExampleQuery but the request is bound to ctx.
+func (c *Collection) ByExampleQueryCtx(ctx context.Context, query *ByExampleQuery) (*Cursor, error) {
 	if query == nil {
 		query = &ByExampleQuery{
 			Example: &struct{}{},
 		}
+	} else {
+		copied := *query
+		query = &copied
 	}
 	query.Collection = c.Name()
-	return c.db.ByExampleQuery(query)
+	return c.db.ByExampleQueryCtx(ctx, query)
 }
 
 func (c *Collection) FirstExample( example, document interface{} ) error{
-    return c.db.FirstExample( &FirstExampleQuery{
+	return c.FirstExampleCtx(context.Background(), example, document)
+}
+
+//FirstExampleCtx is like FirstExample but the request is bound to ctx.
+func (c *Collection) FirstExampleCtx(ctx context.Context, example, document interface{} ) error{
+    return c.db.FirstExampleCtx(ctx, &FirstExampleQuery{
         Collection : c.Name(),
         Example : example,
     }, document )
 }
 
+//All returns a cursor over every document in the collection.
+//query can be nil. Its Collection is ignored.
+func (c *Collection) All(query *AllQuery) (*Cursor, error) {
+	return c.AllCtx(context.Background(), query)
+}
+
+//AllCtx is like All but the request is bound to ctx.
+func (c *Collection) AllCtx(ctx context.Context, query *AllQuery) (*Cursor, error) {
+	var copied AllQuery
+	if query != nil {
+		copied = *query
+	}
+	copied.Collection = c.Name()
+	return c.db.AllCtx(ctx, &copied)
+}
+
+//Any populates document with a random document from the collection.
+func (c *Collection) Any(document interface{}) error {
+	return c.AnyCtx(context.Background(), document)
+}
+
+//AnyCtx is like Any but the request is bound to ctx.
+func (c *Collection) AnyCtx(ctx context.Context, document interface{}) error {
+	return c.db.AnyCtx(ctx, &AnyQuery{Collection: c.Name()}, document)
+}
+
+//Range returns a cursor over the documents with an attribute
+//inside a range. The Collection of the query is ignored.
+func (c *Collection) Range(query *RangeQuery) (*Cursor, error) {
+	return c.RangeCtx(context.Background(), query)
+}
+
+//RangeCtx is like Range but the request is bound to ctx.
+func (c *Collection) RangeCtx(ctx context.Context, query *RangeQuery) (*Cursor, error) {
+	if query == nil {
+		return nil, newError("You must provide a query when calling Range.")
+	}
+	copied := *query
+	copied.Collection = c.Name()
+	return c.db.RangeCtx(ctx, &copied)
+}
+
+//Near returns a cursor over the documents closest to a coordinate.
+//The Collection of the query is ignored.
+func (c *Collection) Near(query *NearQuery) (*Cursor, error) {
+	return c.NearCtx(context.Background(), query)
+}
+
+//NearCtx is like Near but the request is bound to ctx.
+func (c *Collection) NearCtx(ctx context.Context, query *NearQuery) (*Cursor, error) {
+	if query == nil {
+		return nil, newError("You must provide a query when calling Near.")
+	}
+	copied := *query
+	copied.Collection = c.Name()
+	return c.db.NearCtx(ctx, &copied)
+}
+
+//Within returns a cursor over the documents within a radius of
+//a coordinate. The Collection of the query is ignored.
+func (c *Collection) Within(query *WithinQuery) (*Cursor, error) {
+	return c.WithinCtx(context.Background(), query)
+}
+
+//WithinCtx is like Within but the request is bound to ctx.
+func (c *Collection) WithinCtx(ctx context.Context, query *WithinQuery) (*Cursor, error) {
+	if query == nil {
+		return nil, newError("You must provide a query when calling Within.")
+	}
+	copied := *query
+	copied.Collection = c.Name()
+	return c.db.WithinCtx(ctx, &copied)
+}
+
+//Fulltext returns a cursor over the documents matching a fulltext
+//query. The Collection of the query is ignored.
+func (c *Collection) Fulltext(query *FulltextQuery) (*Cursor, error) {
+	return c.FulltextCtx(context.Background(), query)
+}
+
+//FulltextCtx is like Fulltext but the request is bound to ctx.
+func (c *Collection) FulltextCtx(ctx context.Context, query *FulltextQuery) (*Cursor, error) {
+	if query == nil {
+		return nil, newError("You must provide a query when calling Fulltext.")
+	}
+	copied := *query
+	copied.Collection = c.Name()
+	return c.db.FulltextCtx(ctx, &copied)
+}
+
+//First fetches the oldest documents in the collection. If count is 0
+//then result should point to a single document. Otherwise it should
+//point to a slice that receives up to count documents.
+func (c *Collection) First(count int, result interface{}) error {
+	return c.FirstCtx(context.Background(), count, result)
+}
+
+//FirstCtx is like First but the request is bound to ctx.
+func (c *Collection) FirstCtx(ctx context.Context, count int, result interface{}) error {
+	return c.db.FirstCtx(ctx, &FirstLastQuery{Collection: c.Name(), Count: count}, result)
+}
+
+//Last fetches the newest documents in the collection. If count is 0
+//then result should point to a single document. Otherwise it should
+//point to a slice that receives up to count documents.
+func (c *Collection) Last(count int, result interface{}) error {
+	return c.LastCtx(context.Background(), count, result)
+}
+
+//LastCtx is like Last but the request is bound to ctx.
+func (c *Collection) LastCtx(ctx context.Context, count int, result interface{}) error {
+	return c.db.LastCtx(ctx, &FirstLastQuery{Collection: c.Name(), Count: count}, result)
+}
+
+//LookupByKeys fetches the documents with the given keys.
+//documents should point to a slice. Keys that don't exist are skipped.
+func (c *Collection) LookupByKeys(keys []string, documents interface{}) error {
+	return c.LookupByKeysCtx(context.Background(), keys, documents)
+}
+
+//LookupByKeysCtx is like LookupByKeys but the request is bound to ctx.
+func (c *Collection) LookupByKeysCtx(ctx context.Context, keys []string, documents interface{}) error {
+	if keys == nil {
+		keys = []string{}
+	}
+	return c.db.LookupByKeysCtx(ctx, &LookupByKeysQuery{Collection: c.Name(), Keys: keys}, documents)
+}
+
 func (c *Collection) crossCollectionCheck(documentHandle interface{}) (interface{}, bool) {
 
 	switch id := documentHandle.(type) {
@@ -397,6 +771,9 @@ func (c *Collection) crossCollectionCheck(documentHandle interface{}) (interface
 			}
 		}
 	case HasArangoId:
+		if key, ok := documentHandle.(HasArangoKey); ok && id.Id() == "" {
+			return c.keyHandle(documentHandle, key), key.Key() != ""
+		}
 		idParts := strings.Split(id.Id(), "/")
 		if len(idParts) == 2 {
 			if idParts[0] == c.Name() {
@@ -404,8 +781,41 @@ func (c *Collection) crossCollectionCheck(documentHandle interface{}) (interface
 			}
 		}
 	case HasArangoKey:
-		return c.Name() + "/" + id.Key(), true
+		return c.keyHandle(documentHandle, id), true
 	}
 
 	return "", false
 }
+
+//keyHandle turns a document that only has a key into a full
+//document id. When the document also has a revision a revisionHandle
+//is returned instead so the revision isn't lost along the way.
+func (c *Collection) keyHandle(documentHandle interface{}, key HasArangoKey) interface{} {
+	id := c.Name() + "/" + key.Key()
+	if rev, ok := documentHandle.(HasArangoRev); ok && rev.Rev() != "" {
+		return &revisionHandle{id: id, rev: rev.Rev()}
+	}
+	return id
+}
+
+//revisionHandle is a document handle carrying only an id and a revision.
+type revisionHandle struct {
+	id  string
+	rev string
+}
+
+func (h *revisionHandle) Id() string {
+	return h.id
+}
+
+func (h *revisionHandle) SetId(id string) {
+	h.id = id
+}
+
+func (h *revisionHandle) Rev() string {
+	return h.rev
+}
+
+func (h *revisionHandle) SetRev(rev string) {
+	h.rev = rev
+}
@@ -0,0 +1,282 @@
+package arango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	na "github.com/jmcvetta/napping"
+	"net/url"
+)
+
+//CollectionFigures holds the statistics returned by
+//the GET /_api/collection/{collection-name}/figures endpoint.
+type CollectionFigures struct {
+	//Alive counts the documents that are current.
+	Alive CollectionFigure `json:"alive"`
+
+	//Dead counts the old revisions and deletion markers
+	//that the compactor hasn't removed yet.
+	Dead CollectionFigure `json:"dead"`
+
+	Datafiles  CollectionFigure `json:"datafiles"`
+	Journals   CollectionFigure `json:"journals"`
+	Compactors CollectionFigure `json:"compactors"`
+	Shapefiles CollectionFigure `json:"shapefiles"`
+	Shapes     CollectionFigure `json:"shapes"`
+	Attributes CollectionFigure `json:"attributes"`
+	Indexes    CollectionFigure `json:"indexes"`
+
+	LastTick                  string `json:"lastTick"`
+	UncollectedLogfileEntries int64  `json:"uncollectedLogfileEntries"`
+}
+
+//CollectionFigure is one group of figures. Not every
+//group reports every field.
+type CollectionFigure struct {
+	Count    int64 `json:"count"`
+	Size     int64 `json:"size"`
+	FileSize int64 `json:"fileSize"`
+	Deletion int64 `json:"deletion"`
+}
+
+//Truncate removes all documents from the collection but leaves the indexes intact.
+//Uses the PUT /_api/collection/{collection-name}/truncate endpoint.
+func (c *Collection) Truncate() error {
+	return c.TruncateCtx(context.Background())
+}
+
+//TruncateCtx is like Truncate but the request is bound to ctx.
+func (c *Collection) TruncateCtx(ctx context.Context) error {
+	_, err := c.admin(ctx, "PUT", "truncate", nil, &struct{}{})
+	return err
+}
+
+//Load loads the collection into memory.
+//Uses the PUT /_api/collection/{collection-name}/load endpoint.
+func (c *Collection) Load() error {
+	return c.LoadCtx(context.Background())
+}
+
+//LoadCtx is like Load but the request is bound to ctx.
+func (c *Collection) LoadCtx(ctx context.Context) error {
+	_, err := c.admin(ctx, "PUT", "load", nil, &struct {
+		Count bool `json:"count"`
+	}{false})
+	return err
+}
+
+//Unload removes the collection from memory. Arango does this lazily,
+//so Status() may report BEING_UNLOADED_STATUS for a while.
+//Uses the PUT /_api/collection/{collection-name}/unload endpoint.
+func (c *Collection) Unload() error {
+	return c.UnloadCtx(context.Background())
+}
+
+//UnloadCtx is like Unload but the request is bound to ctx.
+func (c *Collection) UnloadCtx(ctx context.Context) error {
+	_, err := c.admin(ctx, "PUT", "unload", nil, &struct{}{})
+	return err
+}
+
+//Rename changes the name of the collection. The Collection keeps
+//working under the new name after this returns.
+//Uses the PUT /_api/collection/{collection-name}/rename endpoint.
+func (c *Collection) Rename(name string) error {
+	return c.RenameCtx(context.Background(), name)
+}
+
+//RenameCtx is like Rename but the request is bound to ctx.
+func (c *Collection) RenameCtx(ctx context.Context, name string) error {
+
+	if name == "" {
+		return newError("You must specify a new name when renaming a collection.")
+	}
+
+	_, err := c.admin(ctx, "PUT", "rename", nil, &struct {
+		Name string `json:"name"`
+	}{name})
+	return err
+}
+
+//Count returns the number of documents in the collection.
+//Uses the GET /_api/collection/{collection-name}/count endpoint.
+func (c *Collection) Count() (int64, error) {
+	return c.CountCtx(context.Background())
+}
+
+//CountCtx is like Count but the request is bound to ctx.
+func (c *Collection) CountCtx(ctx context.Context) (int64, error) {
+
+	result, err := c.admin(ctx, "GET", "count", nil, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Count, nil
+}
+
+//Figures returns statistics about the collection.
+//Uses the GET /_api/collection/{collection-name}/figures endpoint.
+func (c *Collection) Figures() (*CollectionFigures, error) {
+	return c.FiguresCtx(context.Background())
+}
+
+//FiguresCtx is like Figures but the request is bound to ctx.
+func (c *Collection) FiguresCtx(ctx context.Context) (*CollectionFigures, error) {
+
+	result, err := c.admin(ctx, "GET", "figures", nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Figures, nil
+}
+
+//Revision returns the revision of the collection. It changes
+//whenever a document in the collection changes.
+//Uses the GET /_api/collection/{collection-name}/revision endpoint.
+func (c *Collection) Revision() (string, error) {
+	return c.RevisionCtx(context.Background())
+}
+
+//RevisionCtx is like Revision but the request is bound to ctx.
+func (c *Collection) RevisionCtx(ctx context.Context) (string, error) {
+
+	result, err := c.admin(ctx, "GET", "revision", nil, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	return result.Revision, nil
+}
+
+//Checksum returns a checksum over the keys of the documents in the
+//collection. withRevisions includes the revisions and withData the
+//document contents, which makes it slower but catches more changes.
+//Uses the GET /_api/collection/{collection-name}/checksum endpoint.
+func (c *Collection) Checksum(withRevisions, withData bool) (string, error) {
+	return c.ChecksumCtx(context.Background(), withRevisions, withData)
+}
+
+//ChecksumCtx is like Checksum but the request is bound to ctx.
+func (c *Collection) ChecksumCtx(ctx context.Context, withRevisions, withData bool) (string, error) {
+
+	var query url.Values = make(url.Values)
+	query.Add("withRevisions", fmt.Sprintf("%t", withRevisions))
+	query.Add("withData", fmt.Sprintf("%t", withData))
+
+	var checksum struct {
+		Checksum json.Number `json:"checksum"`
+	}
+
+	_, err := c.adminDecode(ctx, "GET", "checksum", &query, nil, &checksum)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(checksum.Checksum), nil
+}
+
+//RotateJournal closes the current journal of the collection
+//and makes it a read-only datafile so it can be compacted.
+//Arango returns an error if the collection has no journal.
+//Uses the PUT /_api/collection/{collection-name}/rotate endpoint.
+func (c *Collection) RotateJournal() error {
+	return c.RotateJournalCtx(context.Background())
+}
+
+//RotateJournalCtx is like RotateJournal but the request is bound to ctx.
+func (c *Collection) RotateJournalCtx(ctx context.Context) error {
+	_, err := c.admin(ctx, "PUT", "rotate", nil, &struct{}{})
+	return err
+}
+
+//SetProperties changes the waitForSync and journalSize properties
+//of the collection. A journalSize of 0 leaves it unchanged. To keep
+//the current waitForSync, call Properties and pass WaitForSync().
+//Uses the PUT /_api/collection/{collection-name}/properties endpoint.
+func (c *Collection) SetProperties(waitForSync bool, journalSize int) error {
+	return c.SetPropertiesCtx(context.Background(), waitForSync, journalSize)
+}
+
+//SetPropertiesCtx is like SetProperties but the request is bound to ctx.
+func (c *Collection) SetPropertiesCtx(ctx context.Context, waitForSync bool, journalSize int) error {
+	_, err := c.admin(ctx, "PUT", "properties", nil, &struct {
+		WaitForSync bool `json:"waitForSync"`
+		JournalSize int  `json:"journalSize,omitempty"`
+	}{waitForSync, journalSize})
+	return err
+}
+
+//admin calls one of the /_api/collection/{collection-name}/{action}
+//endpoints and refreshes the cached collection information with
+//the response.
+func (c *Collection) admin(ctx context.Context, method, action string, query *url.Values, payload interface{}) (*collectionResult, error) {
+	return c.adminDecode(ctx, method, action, query, payload, nil)
+}
+
+//adminDecode is like admin but also decodes the response into extra.
+//The response is decoded on top of a copy of the cached information
+//because most of these endpoints only return some of the properties.
+func (c *Collection) adminDecode(ctx context.Context, method, action string, query *url.Values, payload, extra interface{}) (*collectionResult, error) {
+
+	db := c.db
+
+	var result = c.result().clone()
+	var raw json.RawMessage
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/collection/%s/%s", db.serverUrl.String(), c.Name(), action)
+
+	session := db.sessionCtx(ctx)
+
+	var response *na.Response
+	var err error
+
+	switch method {
+	case "GET":
+		response, err = session.Get(endpoint, query, &raw, &e)
+	default:
+		response, err = session.Put(endpoint, payload, &raw, &e)
+	}
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		if err := json.Unmarshal(raw, result); err != nil {
+			return nil, wrapError(err)
+		}
+		if extra != nil {
+			if err := json.Unmarshal(raw, extra); err != nil {
+				return nil, wrapError(err)
+			}
+		}
+		c.setResult(result)
+		return result, nil
+	default:
+		return nil, e
+	}
+}
+
+//clone copies the collection information deep enough that
+//decoding into the copy doesn't touch the original.
+func (r *collectionResult) clone() *collectionResult {
+	copied := *r
+	copied.ShardKeys = append([]string(nil), r.ShardKeys...)
+	if r.KeyOptions != nil {
+		keyOptions := *r.KeyOptions
+		copied.KeyOptions = &keyOptions
+	}
+	if r.Figures != nil {
+		figures := *r.Figures
+		copied.Figures = &figures
+	}
+	return &copied
+}
@@ -0,0 +1,185 @@
+package arango
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCollectionAdmin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("admin")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err = c.Save(&DummyDocument{Hi: "there"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := c.Count()
+
+	if err != nil || count != 3 {
+		t.Fatalf("Expected a count of 3 but got %d %v", count, err)
+	}
+
+	figures, err := c.Figures()
+
+	if err != nil || figures.Alive.Count != 3 {
+		t.Fatalf("Expected 3 alive documents: %v %+v", err, figures)
+	}
+
+	revision, err := c.Revision()
+
+	if err != nil || revision == "" {
+		t.Fatalf("Expected a revision: %q %v", revision, err)
+	}
+
+	checksum, err := c.Checksum(true, true)
+
+	if err != nil || checksum == "" {
+		t.Fatalf("Expected a checksum: %q %v", checksum, err)
+	}
+
+	if err = c.SetProperties(true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.WaitForSync() {
+		t.Fatal("Expected WaitForSync to be refreshed by SetProperties.")
+	}
+
+	if err = c.Unload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Status() != UNLOADED_STATUS && c.Status() != BEING_UNLOADED_STATUS {
+		t.Fatalf("Expected the collection to be unloaded but the status is %d", c.Status())
+	}
+
+	if err = c.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Status() != LOADED_STATUS {
+		t.Fatalf("Expected the collection to be loaded but the status is %d", c.Status())
+	}
+
+	if err = c.Rename("admin_renamed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Name() != "admin_renamed" || !c.WaitForSync() {
+		t.Fatalf("Expected the name to change and the other properties to stay: %s", c.Name())
+	}
+
+	if err = c.Truncate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count, err = c.Count(); err != nil || count != 0 {
+		t.Fatalf("Expected an empty collection after truncating but got %d %v", count, err)
+	}
+}
+
+func TestCollectionAdminRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/_db/_system/_api/collection":
+			fmt.Fprint(w, `{"id":"1","name":"admin","status":3,"type":2}`)
+		case strings.HasSuffix(r.URL.Path, "/properties") && r.Method == "GET":
+			fmt.Fprint(w, `{"id":"1","name":"admin","status":3,"type":2,"waitForSync":true,"journalSize":1048576,"keyOptions":{"type":"traditional"}}`)
+		case strings.HasSuffix(r.URL.Path, "/properties"):
+			fmt.Fprint(w, `{"id":"1","name":"renamed","status":3,"type":2,"waitForSync":false,"journalSize":1048576}`)
+		case strings.HasSuffix(r.URL.Path, "/unload"):
+			fmt.Fprint(w, `{"id":"1","name":"admin","status":4,"type":2}`)
+		case strings.HasSuffix(r.URL.Path, "/rename"):
+			fmt.Fprint(w, `{"id":"1","name":"renamed","status":3,"type":2}`)
+		case strings.HasSuffix(r.URL.Path, "/count"):
+			fmt.Fprint(w, `{"id":"1","name":"renamed","status":3,"type":2,"waitForSync":true,"count":7}`)
+		case strings.HasSuffix(r.URL.Path, "/checksum"):
+			fmt.Fprint(w, `{"id":"1","name":"renamed","status":3,"type":2,"checksum":1234,"revision":"99"}`)
+		case strings.HasSuffix(r.URL.Path, "/rotate"):
+			w.WriteHeader(400)
+			fmt.Fprint(w, `{"error":true,"code":400,"errorNum":1105,"errorMessage":"no journal"}`)
+		default:
+			fmt.Fprint(w, `{"id":"1","name":"admin","status":3,"type":2}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("admin")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Properties(); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.reset()
+
+	if err = c.Unload(); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Status() != BEING_UNLOADED_STATUS || !c.WaitForSync() || c.KeyOptions().Type != "traditional" {
+		t.Fatalf("Expected the status to be refreshed and the properties kept: %d %t", c.Status(), c.WaitForSync())
+	}
+
+	if err = c.Rename(""); err == nil {
+		t.Fatal("Expected an error when renaming to a blank name.")
+	}
+
+	if err = c.Rename("renamed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Name() != "renamed" {
+		t.Fatalf("Expected the collection to be renamed but the name is %s", c.Name())
+	}
+
+	count, err := c.Count()
+
+	if err != nil || count != 7 {
+		t.Fatalf("Expected a count of 7: %d %v", count, err)
+	}
+
+	checksum, err := c.Checksum(true, false)
+
+	if err != nil || checksum != "1234" {
+		t.Fatalf("Expected the checksum 1234: %q %v", checksum, err)
+	}
+
+	if err = c.SetProperties(false, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.RotateJournal(); err == nil || err.(ArangoError).ErrorNum != 1105 {
+		t.Fatalf("Expected the arango error to be returned but got %v", err)
+	}
+
+	rec.check(t,
+		`PUT /collection/admin/unload {}`,
+		`PUT /collection/admin/rename {"name":"renamed"}`,
+		`GET /collection/renamed/count`,
+		`GET /collection/renamed/checksum?withData=false&withRevisions=true`,
+		`PUT /collection/renamed/properties {"waitForSync":false}`,
+		`PUT /collection/renamed/rotate {}`,
+	)
+}
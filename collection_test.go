@@ -1,7 +1,10 @@
 package arango
 
 import (
-    
+    "errors"
+    "fmt"
+    "net/http"
+    "sync"
     "testing"
 )
 
@@ -164,3 +167,274 @@ func TestSavingAndRetrievingDocument( t *testing.T ){
     }
 
 }
+
+func TestCollectionConcurrentUse(t *testing.T) {
+
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"1","name":"testing","status":3,"type":2,"journalSize":1024}`)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("testing")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := c.Properties(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if c.Name() != "testing" {
+				t.Error("Expected the collection name to stay the same while refreshing properties.")
+			}
+			c.Status()
+			c.JournalSize()
+		}()
+	}
+	wg.Wait()
+
+	if c.JournalSize() != 1024 {
+		t.Fatalf("Expected the refreshed journal size but got %d", c.JournalSize())
+	}
+}
+
+func TestCollectionDelete(t *testing.T) {
+
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("testing")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := make([]*DummyFullDocument, 4)
+	for i := range docs {
+		docs[i] = &DummyFullDocument{Hi: "delete me"}
+		if err = c.Save(docs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//Cross collection deletes are refused
+	if err = c.Delete("fake/" + docs[0].Key()); err == nil {
+		t.Fatal("Expected a cross collection error.")
+	}
+
+	//Delete by key
+	if err = c.Delete(docs[0].Key()); err != nil {
+		t.Fatal(err)
+	}
+
+	//Delete by full id
+	if err = c.Delete(docs[1].Id()); err != nil {
+		t.Fatal(err)
+	}
+
+	//A stale revision is refused
+	current := docs[2].Rev()
+	docs[2].SetRev("1")
+	err = c.Delete(docs[2])
+
+	if !IsConflict(err) {
+		t.Fatal("Expected a precondition error when deleting with a stale revision.", err)
+	}
+
+	//Delete by document with the current revision
+	docs[2].SetRev(current)
+	if err = c.Delete(docs[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.DeleteWithOptions(docs[3], &DeleteOptions{WaitForSync: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range docs {
+		err = c.Document(d.Key(), &DummyFullDocument{})
+		if !errors.Is(err, ErrDocumentNotFound) {
+			t.Fatalf("Expected the document %s to be gone but got %v", d.Id(), err)
+		}
+	}
+}
+
+type keyRevDocument struct {
+	key string
+	rev string
+}
+
+func (d *keyRevDocument) Key() string       { return d.key }
+func (d *keyRevDocument) SetKey(key string) { d.key = key }
+func (d *keyRevDocument) Rev() string       { return d.rev }
+func (d *keyRevDocument) SetRev(rev string) { d.rev = rev }
+
+func TestCollectionEdgeEndpoints(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api", "If-Match")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(202)
+		}
+		fmt.Fprint(w, `{"id":"1","name":"edges","status":3,"type":3}`)
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateEdgeCollection("edges")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.UpdateEdge("1", &EdgeImplementation{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.DeleteEdge(&keyRevDocument{key: "2", rev: "99"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /collection {"doCompact":true,"type":3,"name":"edges"}`,
+		`PATCH /edge/edges/1? {}`,
+		`DELETE /edge/edges/2?policy=error&waitForSync=false If-Match:99`,
+	)
+}
+
+func TestCollectionEdges(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vertices, err := db.CreateDocumentCollection("vertices")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edges, err := db.CreateEdgeCollection("edges")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, b, c := &DummyFullDocument{Hi: "a"}, &DummyFullDocument{Hi: "b"}, &DummyFullDocument{Hi: "c"}
+	vertices.Save(a)
+	vertices.Save(b)
+	vertices.Save(c)
+
+	edges.SaveEdge(a, b, &EdgeImplementation{})
+	edges.SaveEdge(a, c, &EdgeImplementation{})
+	edges.SaveEdge(c, a, &EdgeImplementation{})
+
+	var found []EdgeImplementation
+
+	if err = edges.Edges(a, DIRECTION_OUT, &found, nil); err != nil || len(found) != 2 {
+		t.Fatalf("Expected 2 outbound edges: %+v %v", found, err)
+	}
+
+	found = nil
+	if err = edges.Edges(a.Id(), DIRECTION_IN, &found, nil); err != nil || len(found) != 1 || found[0].From() != c.Id() {
+		t.Fatalf("Expected 1 inbound edge from c: %+v %v", found, err)
+	}
+
+	found = nil
+	if err = edges.Edges(a, "", &found, nil); err != nil || len(found) != 3 {
+		t.Fatalf("Expected 3 edges in any direction: %+v %v", found, err)
+	}
+
+	found = nil
+	if err = edges.Edges(a.Key(), DIRECTION_OUT, &found, &EdgesOptions{VertexCollection: vertices.Name()}); err != nil || len(found) != 2 {
+		t.Fatalf("Expected the key to be resolved in the vertex collection: %+v %v", found, err)
+	}
+}
+
+func TestCollectionEdgesRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/_system/_api/collection" {
+			fmt.Fprint(w, `{"id":"1","name":"edges","status":3,"type":3}`)
+			return
+		}
+		fmt.Fprint(w, `{"edges":[{"_id":"edges/1","_from":"v/1","_to":"v/2"}],"error":false,"code":200}`)
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateEdgeCollection("edges")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found []EdgeImplementation
+
+	if err = c.Edges(&keyRevDocument{key: "1"}, DIRECTION_OUT, &found, nil); err == nil {
+		t.Fatal("Expected an error when the vertex only has a key and there is no vertex collection.")
+	}
+
+	if err = c.Edges("1", DIRECTION_OUT, &found, &EdgesOptions{}); err == nil {
+		t.Fatal("Expected an error when the vertex isn't a full id.")
+	}
+
+	if err = c.Edges("v/1", "sideways", &found, nil); err == nil {
+		t.Fatal("Expected an error for an unknown direction.")
+	}
+
+	rec.reset()
+
+	vertex := &DummyFullDocument{}
+	vertex.SetId("v/1")
+
+	if err = c.Edges(vertex, DIRECTION_OUT, &found, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(found) != 1 || found[0].To() != "v/2" {
+		t.Fatalf("Expected the edges to be decoded: %+v", found)
+	}
+
+	if err = c.Edges("v/1", "", &found, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Edges(&keyRevDocument{key: "2"}, DIRECTION_IN, &found, &EdgesOptions{VertexCollection: "v"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Edges("3", DIRECTION_IN, &found, &EdgesOptions{VertexCollection: "v"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`GET /edges/edges?direction=out&vertex=v%2F1`,
+		`GET /edges/edges?direction=any&vertex=v%2F1`,
+		`GET /edges/edges?direction=in&vertex=v%2F2`,
+		`GET /edges/edges?direction=in&vertex=v%2F3`,
+	)
+}
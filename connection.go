@@ -1,12 +1,16 @@
 package arango
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
 	na "github.com/jmcvetta/napping"
 	"net"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 var (
@@ -49,9 +53,9 @@ type unixDialer struct {
     path string
 }
 
-// overriding net.Dialer.Dial to force unix socket connection
-func (d *unixDialer) Dial(network, address string) (net.Conn, error) {
-	return d.Dialer.Dial("unix", d.path)
+// overriding net.Dialer.DialContext to force unix socket connection
+func (d *unixDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return d.Dialer.DialContext(ctx, "unix", d.path)
 }
 
 //ConnDbUserPassword returns a new database to an arango server.
@@ -62,80 +66,345 @@ func (d *unixDialer) Dial(network, address string) (net.Conn, error) {
 //and specify the user and password separately. Otherwise, you can
 //just use ConnDb and specify the user info in the host string
 func ConnDbUserPassword(host, databaseName, user, password string) (*Database, error) {
+	return ConnDbUserPasswordCtx(context.Background(), host, databaseName, user, password)
+}
+
+//ConnDbUserPasswordCtx is like ConnDbUserPassword but the request
+//made to check the connection is bound to ctx.
+func ConnDbUserPasswordCtx(ctx context.Context, host, databaseName, user, password string) (*Database, error) {
 
 	if databaseName == "" {
 		return nil, ArangoError{IsError: true, ErrorMessage: "A blank database was specified but that is not allowed."}
 	}
 
-	parsedUrl, err := url.Parse(host)
+	return ConnWithOptionsCtx(ctx, &ConnOptions{
+		Host:     host,
+		Database: databaseName,
+		User:     user,
+		Password: password,
+		TLSConfig: &tls.Config{
+			InsecureSkipVerify: AllowBadSslCerts,
+		},
+	})
+}
+
+//ConnOptions holds everything needed to connect to an arango server.
+//The settings only apply to the connection created with them, so
+//several connections with different options can be used side by side.
+//Only Host is required.
+type ConnOptions struct {
+	//Host is the server address in the same forms accepted by Conn.
+	//It can be left blank when Endpoints is set.
+	Host string
+
+	//Endpoints lists more servers, like http://host:port, that serve
+	//the same data as Host. See endpoints.go for how requests are
+	//spread across them and failed over. Credentials always come
+	//from Host or User/Password.
+	Endpoints []string
+
+	//RoundRobin spreads read requests across all healthy endpoints.
+	//Otherwise every request goes to the first healthy endpoint,
+	//which is what active/passive setups want.
+	RoundRobin bool
+
+	//EndpointRetryInterval is how long an endpoint that failed is
+	//skipped before requests are sent to it again. Defaults to 5 seconds.
+	EndpointRetryInterval time.Duration
+
+	//Database to connect to. Defaults to _system if blank.
+	Database string
+
+	//User and Password override any credentials in Host.
+	User     string
+	Password string
+
+	//Client is used as is to send every request when it is set.
+	//The transport related options below are ignored in that case.
+	Client *http.Client
+
+	//Transport is used to build the http.Client when Client is nil.
+	//The dial, idle connection, TLS and proxy options below are
+	//ignored when it is set.
+	Transport http.RoundTripper
+
+	//Timeout limits the total time of each request including
+	//reading the response. Zero means no timeout.
+	Timeout time.Duration
+
+	//DialTimeout limits how long establishing a connection may take.
+	//Zero means no timeout.
+	DialTimeout time.Duration
+
+	//TLSHandshakeTimeout limits how long the TLS handshake may take.
+	//Zero means no timeout.
+	TLSHandshakeTimeout time.Duration
+
+	//MaxIdleConns and MaxIdleConnsPerHost control how many keep-alive
+	//connections are kept around. Zero uses the net/http defaults.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+
+	//IdleConnTimeout is how long an idle connection is kept before
+	//it is closed. Zero means idle connections are never closed.
+	IdleConnTimeout time.Duration
+
+	//TLSConfig is used for https connections. Set RootCAs to trust
+	//a custom CA and Certificates to present a client certificate.
+	TLSConfig *tls.Config
+
+	//Proxy selects the proxy for each request. Use
+	//http.ProxyFromEnvironment to honour HTTP_PROXY and friends.
+	//No proxy is used when it is nil.
+	Proxy func(*http.Request) (*url.URL, error)
+}
+
+//ConnWithOptions returns a new database connection to an arango
+//server configured by options.
+func ConnWithOptions(options *ConnOptions) (*Database, error) {
+	return ConnWithOptionsCtx(context.Background(), options)
+}
+
+//ConnWithOptionsCtx is like ConnWithOptions but the request
+//made to check the connection is bound to ctx.
+func ConnWithOptionsCtx(ctx context.Context, options *ConnOptions) (*Database, error) {
+
+	if options == nil {
+		return nil, newError("You must provide connection options when using ConnWithOptions.")
+	}
+
+	return connect(ctx, *options, nil)
+}
+
+//connect does the work for ConnWithOptionsCtx. When endpoints is
+//not nil the client in opts already sends its requests through it
+//so it is shared instead of wrapping the client again.
+func connect(ctx context.Context, opts ConnOptions, endpoints *endpointTransport) (*Database, error) {
+
+	shared := endpoints != nil
+
+	if opts.Host == "" && len(opts.Endpoints) > 0 {
+		opts.Host = opts.Endpoints[0]
+	}
+
+	if opts.Database == "" {
+		opts.Database = "_system"
+	}
+
+	parsedUrl, err := url.Parse(opts.Host)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if user != "" {
-		parsedUrl.User = url.UserPassword(user, password)
+	if opts.User != "" {
+		parsedUrl.User = url.UserPassword(opts.User, opts.Password)
 	}
 
 	var db = new(Database)
 	db.json = new(databaseResult)
 	db.serverUrl = parsedUrl
-	db.originalUrl = &url.URL{
-        Scheme: parsedUrl.Scheme,
-        Opaque: parsedUrl.Opaque,
-        User: parsedUrl.User,
-        Host: parsedUrl.Host,
-        Path: parsedUrl.Path,
-        RawQuery: parsedUrl.RawQuery,
-        Fragment: parsedUrl.Fragment,
-    }
+	db.options = opts
 	db.session = new(na.Session)
 
+	dialer := &net.Dialer{
+		Timeout: opts.DialTimeout,
+	}
+
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     opts.TLSConfig,
+		TLSHandshakeTimeout: opts.TLSHandshakeTimeout,
+		MaxIdleConns:        opts.MaxIdleConns,
+		MaxIdleConnsPerHost: opts.MaxIdleConnsPerHost,
+		IdleConnTimeout:     opts.IdleConnTimeout,
+		Proxy:               opts.Proxy,
+	}
+
 	switch parsedUrl.Scheme {
 	case "http", "https":
-
-		//Create transport that will allow
-		//bad ssl certs if we allow it
-		db.session.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: AllowBadSslCerts,
-				},
-			},
+		if endpoints == nil {
+			endpoints, err = newEndpointTransport(parsedUrl, &opts)
+			if err != nil {
+				return nil, err
+			}
 		}
 	case "unix":
-		//Create transport that will allow
-		//bad ssl certs if we allow it
-		db.session.Client = &http.Client{
-			Transport: &http.Transport{
-                Dial: (&unixDialer{
-                        net.Dialer{},
-                        parsedUrl.Path,
-                    }).Dial,
-			},
+		if len(opts.Endpoints) > 0 {
+			return nil, newError("Multiple endpoints are not supported for unix sockets.")
 		}
-        parsedUrl.Scheme = "http"
-        parsedUrl.Host = "localhost-socket"
+		transport.DialContext = (&unixDialer{
+			*dialer,
+			parsedUrl.Path,
+		}).DialContext
+		transport.Proxy = nil
+		parsedUrl.Scheme = "http"
+		parsedUrl.Host = "localhost-socket"
 	default:
 		return nil, newError(fmt.Sprintf("The %s scheme is not supported yet.", parsedUrl.Scheme))
 	}
 
-    parsedUrl.Path = "/_db/" + databaseName + "/_api"
+	var client http.Client
+	switch {
+	case opts.Client != nil:
+		client = *opts.Client
+	case opts.Transport != nil:
+		client.Transport = opts.Transport
+		client.Timeout = opts.Timeout
+	default:
+		client.Transport = transport
+		client.Timeout = opts.Timeout
+	}
+
+	if endpoints != nil && !shared {
+		endpoints.base = client.Transport
+		if endpoints.base == nil {
+			endpoints.base = http.DefaultTransport
+		}
+		client.Transport = endpoints
+	}
+	db.endpoints = endpoints
+	db.session.Client = &client
+
+	parsedUrl.Path = "/_db/" + opts.Database + "/_api"
 
 	var e ArangoError
-	response, err := db.session.Get(db.serverUrl.String()+"/database/current", nil, db.json, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(db.serverUrl.String()+"/database/current", nil, db.json, &e)
 
 	if err != nil {
-		return nil, newError(err.Error())
+		return nil, wrapError(err)
 	}
 
 	switch response.Status() {
 	case 200:
 		return db, nil
 	case 401:
-		return nil, newError("401 Unauthorized: check user password.")
+		return nil, ArangoError{
+			IsError:      true,
+			Code:         401,
+			ErrorNum:     ERROR_HTTP_UNAUTHORIZED,
+			ErrorMessage: "401 Unauthorized: check user password.",
+		}
 	default:
 		return nil, e
 	}
 
 }
+
+//contextTransport binds every request that goes through
+//it to ctx before handing it off to the wrapped transport.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+//sessionCtx returns a copy of the database session whose requests
+//are canceled when ctx is done. napping has no notion of a context
+//so we bind it at the transport level instead.
+func (db *Database) sessionCtx(ctx context.Context) *na.Session {
+	return db.sessionWithHeader(ctx, nil)
+}
+
+//sessionWithHeader is like sessionCtx but the returned session also
+//sends the given headers. Each call gets its own copy of the session
+//and its headers so the shared session is never mutated. This is what
+//keeps a Database safe to use from several goroutines.
+func (db *Database) sessionWithHeader(ctx context.Context, header http.Header) *na.Session {
+	session := *db.session
+
+	var client http.Client
+	if session.Client != nil {
+		client = *session.Client
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.Transport = &contextTransport{ctx: ctx, base: base}
+	session.Client = &client
+
+	if session.Header != nil || len(header) > 0 {
+		merged := make(http.Header)
+		if session.Header != nil {
+			for k, v := range *session.Header {
+				merged[k] = append([]string(nil), v...)
+			}
+		}
+		for k, v := range header {
+			merged[k] = append([]string(nil), v...)
+		}
+		session.Header = &merged
+	}
+
+	return &session
+}
+
+//sendRaw sends body as is instead of json encoding it like napping does.
+//It's used by the endpoints that don't take a single json value, like
+///_api/import. The response is decoded into result for 2xx responses
+//and into e otherwise, just like napping would.
+func (db *Database) sendRaw(ctx context.Context, method, endpoint string, header http.Header, body io.Reader, result, e interface{}) (int, error) {
+
+	response, data, err := db.doRaw(ctx, method, endpoint, header, body)
+
+	if err != nil {
+		if response != nil {
+			return response.StatusCode, err
+		}
+		return 0, err
+	}
+
+	var target = result
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		target = e
+	}
+
+	if target != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, target); err != nil {
+			return response.StatusCode, err
+		}
+	}
+
+	return response.StatusCode, nil
+}
+
+//doRaw sends body as is and returns the response along with its
+//whole body, for endpoints like /_api/batch that don't answer with json.
+func (db *Database) doRaw(ctx context.Context, method, endpoint string, header http.Header, body io.Reader) (*http.Response, []byte, error) {
+
+	session := db.sessionWithHeader(ctx, header)
+
+	req, err := http.NewRequest(method, endpoint, body)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if session.Header != nil {
+		for k, v := range *session.Header {
+			req.Header[k] = v
+		}
+	}
+
+	response, err := session.Client.Do(req)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return response, nil, err
+	}
+
+	return response, data, nil
+}
@@ -1,7 +1,18 @@
 package arango
 
 import (
+    "bytes"
+    "crypto/tls"
+    "crypto/x509"
+    "fmt"
+    "io"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "sync"
+    "sync/atomic"
     "testing"
+    "time"
 )
 
 func TestConnectionSuccessful( t *testing.T ){
@@ -139,3 +150,193 @@ func TestFailUsingDatabaseNameAndUserCreds( t *testing.T ){
     }
 
 }
+
+//fakeServer starts a local http server that answers the connection
+//check so a Database can be pointed at it without a running arangod.
+//Every other request is handed to handler.
+func fakeServer(handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(fakeHandler(handler))
+}
+
+func fakeHandler(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/_api/database/current") {
+			fmt.Fprint(w, `{"result":{"name":"_system","id":"1","path":"/tmp","isSystem":true},"error":false,"code":200}`)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+//requestRecorder keeps a line for every request a fake server
+//receives so tests can check what was sent. A line is the method,
+//the request uri without prefix, the headers that are set and the
+//body, separated by spaces, like:
+//
+//  PUT /collection/things/rename If-Match:99 {"name":"other"}
+type requestRecorder struct {
+	prefix  string
+	headers []string
+
+	mu     sync.Mutex
+	lines  []string
+	bodies [][]byte
+}
+
+//newRequestRecorder returns a recorder that cuts prefix from the
+//request uris and adds the given headers to the lines.
+func newRequestRecorder(prefix string, headers ...string) *requestRecorder {
+	return &requestRecorder{prefix: prefix, headers: headers}
+}
+
+//handler records every request before handing it to next,
+//which can still read the body.
+func (rec *requestRecorder) handler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rec.record(r, body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next(w, r)
+	}
+}
+
+//record adds a request whose body was already read.
+func (rec *requestRecorder) record(r *http.Request, body []byte) {
+	line := []string{r.Method, strings.TrimPrefix(r.URL.RequestURI(), rec.prefix)}
+	for _, header := range rec.headers {
+		if value := r.Header.Get(header); value != "" {
+			line = append(line, header+":"+value)
+		}
+	}
+	line = append(line, strings.TrimSpace(string(body)))
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.lines = append(rec.lines, strings.TrimSpace(strings.Join(line, " ")))
+	rec.bodies = append(rec.bodies, body)
+}
+
+//reset forgets the requests recorded so far.
+func (rec *requestRecorder) reset() {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.lines = nil
+	rec.bodies = nil
+}
+
+//count returns the number of recorded requests.
+func (rec *requestRecorder) count() int {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return len(rec.lines)
+}
+
+//body returns the body of the i-th recorded request.
+func (rec *requestRecorder) body(i int) []byte {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.bodies[i]
+}
+
+//check fails t unless exactly the expected requests were
+//recorded, in that order.
+func (rec *requestRecorder) check(t *testing.T, expected ...string) {
+	t.Helper()
+
+	rec.mu.Lock()
+	lines := append([]string(nil), rec.lines...)
+	rec.mu.Unlock()
+
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d requests but got %q", len(expected), lines)
+	}
+
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Fatalf("Expected request %d to be %q but got %q", i, expected[i], lines[i])
+		}
+	}
+}
+
+type countingTransport struct {
+	count int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.count, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestConnWithOptionsTLS(t *testing.T) {
+	server := httptest.NewTLSServer(fakeHandler(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := ConnWithOptions(&ConnOptions{Host: server.URL})
+
+	if err == nil {
+		t.Fatal("Expected an error because the server certificate is not trusted.")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Host:      server.URL,
+		TLSConfig: &tls.Config{RootCAs: pool},
+	})
+
+	if err != nil {
+		t.Fatal("Expected to connect when trusting the server certificate.", err)
+	}
+
+	if db.Name() != "_system" {
+		t.Error("Did not get default database _system.")
+	}
+}
+
+func TestConnWithOptionsTransportAndTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	transport := &countingTransport{}
+	db, err := ConnWithOptions(&ConnOptions{
+		Host:      server.URL,
+		Transport: transport,
+		Timeout:   50 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if atomic.LoadInt32(&transport.count) != 1 {
+		t.Fatal("Expected the connection check to go through the given transport.")
+	}
+
+	_, err = db.Collection("testing")
+
+	if err == nil {
+		t.Fatal("Expected the request to time out.")
+	}
+
+	//switching databases keeps using the same client
+	db, err = db.UseDatabase("other")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if atomic.LoadInt32(&transport.count) != 3 {
+		t.Fatalf("Expected UseDatabase to keep the given transport but got %d requests.", transport.count)
+	}
+
+	_, err = ConnWithOptions(nil)
+
+	if err == nil {
+		t.Fatal("Expected an error when connecting without options.")
+	}
+}
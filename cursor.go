@@ -1,6 +1,7 @@
 package arango
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -20,21 +21,61 @@ type cursorResult struct {
 	Error   bool              `json:"error"`
 	Code    int               `json:"code"`
 	Id      string            `json:"id"`
+	Extra   struct {
+		Stats    QueryStats     `json:"stats"`
+		Warnings []QueryWarning `json:"warnings"`
+	} `json:"extra"`
 }
 
-func (c Cursor) HasMore() bool {
+//QueryStats holds the execution statistics arango returns
+//along with the results of an AQL query.
+type QueryStats struct {
+	WritesExecuted int `json:"writesExecuted"`
+	WritesIgnored  int `json:"writesIgnored"`
+	ScannedFull    int `json:"scannedFull"`
+	ScannedIndex   int `json:"scannedIndex"`
+	FullCount      int `json:"fullCount"`
+}
+
+//QueryWarning is a warning produced by arango while
+//executing an AQL query.
+type QueryWarning struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (c *Cursor) HasMore() bool {
 	return len(c.json.Result) > 0 || c.json.HasMore
 }
 
-func (c Cursor) Count() int {
+func (c *Cursor) Count() int {
 	return c.json.Count
 }
 
-func (c Cursor) Error() bool {
+//FullCount returns the number of results the query would have
+//produced without its final LIMIT. It is only populated when
+//the query was run with FullCount set to true.
+func (c *Cursor) FullCount() int {
+	return c.json.Extra.Stats.FullCount
+}
+
+//Stats returns the execution statistics of the AQL query that
+//produced this cursor. It is blank for simple queries.
+func (c *Cursor) Stats() QueryStats {
+	return c.json.Extra.Stats
+}
+
+//Warnings returns any warnings produced while running the
+//AQL query that produced this cursor.
+func (c *Cursor) Warnings() []QueryWarning {
+	return c.json.Extra.Warnings
+}
+
+func (c *Cursor) Error() bool {
 	return c.json.Error
 }
 
-func (c Cursor) Code() int {
+func (c *Cursor) Code() int {
 	return c.json.Code
 }
 
@@ -46,59 +87,158 @@ func (c Cursor) Code() int {
 //is exhausted. Once the hasMore attribute has a value 
 //of false, the client can stop. 
 func (c *Cursor) Next(next interface{}) error {
+	return c.NextCtx(context.Background(), next)
+}
 
-	if len(c.json.Result) > 0 {
-		err := json.Unmarshal(c.json.Result[0], next)
-		if err != nil {
-			return newError(err.Error())
-		}
-		c.json.Result = c.json.Result[1:len(c.json.Result)]
-		return nil
-	} else if c.json.Id != "" {
-		endpoint := fmt.Sprintf("%s/cursor/%s",
-			c.db.serverUrl.String(),
-			c.json.Id,
-		)
-
-		var e ArangoError
-		response, err := c.db.session.Put(endpoint, nil, &c.json, &e)
+//NextCtx is like Next but the request is bound to ctx.
+func (c *Cursor) NextCtx(ctx context.Context, next interface{}) error {
 
-		if err != nil {
-			return newError(err.Error())
-		}
-		switch response.Status() {
-		case 200:
-			if len(c.json.Result) > 0 {
-				err := json.Unmarshal(c.json.Result[0], next)
-				if err != nil {
-					return newError(err.Error())
-				}
-				c.json.Result = c.json.Result[1:len(c.json.Result)]
-			}
-			return nil
-		default:
+	//keep fetching while arango hands back empty batches
+	//that still claim to have more behind them
+	for len(c.json.Result) == 0 {
+		if !c.json.HasMore || c.json.Id == "" {
+			e := newError("You called Next on a cursor that is invalid or doesn't have anymore results to return.")
+			e.cause = &errorCause{err: ErrNoMoreResults}
 			return e
 		}
+		if err := c.fetch(ctx); err != nil {
+			return err
+		}
+	}
+
+	err := json.Unmarshal(c.json.Result[0], next)
+	if err != nil {
+		return wrapError(err)
 	}
-	return newError("You called Next on a cursor that is invalid or doesn't have anymore results to return.")
+	c.json.Result = c.json.Result[1:len(c.json.Result)]
+	return nil
 }
 
-func (c Cursor) Close() error {
+//fetch replaces the current batch with the next one
+//from the server.
+func (c *Cursor) fetch(ctx context.Context) error {
 	endpoint := fmt.Sprintf("%s/cursor/%s",
 		c.db.serverUrl.String(),
 		c.json.Id,
 	)
+
 	var e ArangoError
-	response, err := c.db.session.Delete(endpoint, nil, &e)
+	session := c.db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, nil, &c.json, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
+	}
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
+
+//Close deletes the cursor on the server. Cursors that were
+//read to the end are removed by arango on its own so Close
+//does nothing for them.
+func (c *Cursor) Close() error {
+	return c.CloseCtx(context.Background())
+}
+
+//CloseCtx is like Close but the request is bound to ctx.
+func (c *Cursor) CloseCtx(ctx context.Context) error {
+	if c.json.Id == "" || !c.json.HasMore {
+		return nil
+	}
+
+	endpoint := fmt.Sprintf("%s/cursor/%s",
+		c.db.serverUrl.String(),
+		c.json.Id,
+	)
+	var e ArangoError
+	session := c.db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, nil, &e)
+
+	if err != nil {
+		return wrapError(err)
 	}
 
 	switch response.Status() {
 	case 202:
+		c.json.Result = nil
+		c.json.HasMore = false
 		return nil
 	default:
 		return e
 	}
 }
+
+//Stream sends the remaining results of the cursor on the returned
+//channel, which holds up to bufferSize results. The next batch is
+//fetched from the server while the current one is being consumed
+//so batch boundaries don't stall the reader.
+//
+//The results channel is closed once the cursor is exhausted or
+//something fails. The error channel then receives the error, if
+//any, and is closed as well. When streaming stops early, because
+//ctx is cancelled or a batch can't be fetched, the cursor is
+//deleted on the server. Don't use the cursor otherwise while
+//it's streaming.
+//
+//Either read results until it is closed or cancel ctx. A reader
+//that simply stops leaves Stream blocked forever and the cursor
+//open on the server until its ttl runs out.
+func (c *Cursor) Stream(ctx context.Context, bufferSize int) (<-chan json.RawMessage, <-chan error) {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	results := make(chan json.RawMessage, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(results)
+
+		for {
+			//decoding the next batch reuses the Result slice so
+			//hand the current one over before fetching
+			batch := c.json.Result
+			c.json.Result = nil
+
+			var fetched chan error
+			if c.json.HasMore && c.json.Id != "" {
+				fetched = make(chan error, 1)
+				go func() {
+					fetched <- c.fetch(ctx)
+				}()
+			}
+
+			for _, result := range batch {
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					if fetched != nil {
+						<-fetched
+					}
+					//ctx is done so it can't be used to clean up
+					c.CloseCtx(context.Background())
+					errs <- wrapError(ctx.Err())
+					return
+				}
+			}
+
+			if fetched == nil {
+				return
+			}
+
+			if err := <-fetched; err != nil {
+				//ctx may be done so it can't be used to clean up
+				c.CloseCtx(context.Background())
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	return results, errs
+}
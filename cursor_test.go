@@ -1,3 +1,250 @@
 package arango
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
 
+func TestTypedCursor(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"result":[{"Hi":"a"},{"Hi":"b"}],"hasMore":true,"id":"7","count":5,"error":false,"code":201}`)
+		case "PUT":
+			//an empty batch first to make sure Next keeps going
+			if rec.count() == 2 {
+				fmt.Fprint(w, `{"result":[],"hasMore":true,"id":"7","error":false,"code":200}`)
+				return
+			}
+			fmt.Fprint(w, `{"result":[{"Hi":"c"},{"Hi":"d"},{"Hi":"e"}],"hasMore":false,"id":"7","error":false,"code":200}`)
+		case "DELETE":
+			w.WriteHeader(202)
+			fmt.Fprint(w, `{"id":"7","error":false,"code":202}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, err := QueryTyped[DummyDocument](db, NewAqlQuery("FOR d IN docs RETURN d"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cur.Cursor().Count() != 5 {
+		t.Fatalf("Expected a count of 5 but got %d", cur.Cursor().Count())
+	}
+
+	docs, err := cur.ReadAll()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 5 || docs[0].Hi != "a" || docs[4].Hi != "e" {
+		t.Fatalf("Expected all five documents: %+v", docs)
+	}
+
+	if _, err = cur.Next(); err != ErrNoMoreResults {
+		t.Fatalf("Expected ErrNoMoreResults from an exhausted cursor but got %v", err)
+	}
+
+	var plain Cursor
+	plain.db = db
+
+	if err = plain.Next(&DummyDocument{}); !errors.Is(err, ErrNoMoreResults) {
+		t.Fatalf("Expected Cursor.Next to wrap ErrNoMoreResults but got %v", err)
+	}
+
+	rec.check(t,
+		`POST /cursor {"query":"FOR d IN docs RETURN d"}`,
+		`PUT /cursor/7`,
+		`PUT /cursor/7`,
+	)
+
+	rec.reset()
+
+	cur, err = QueryTyped[DummyDocument](db, NewAqlQuery("FOR d IN docs RETURN d"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for doc, err := range cur.All() {
+		if err != nil || doc.Hi != "a" {
+			t.Fatalf("Unexpected first document %+v %v", doc, err)
+		}
+		break
+	}
+
+	stop := errors.New("stop")
+	cur, _ = QueryTyped[DummyDocument](db, NewAqlQuery("FOR d IN docs RETURN d"))
+
+	if err = cur.ForEach(func(doc DummyDocument) error { return stop }); err != stop {
+		t.Fatalf("Expected the error from the callback but got %v", err)
+	}
+
+	if err = cur.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	//the cursors are deleted once each
+	rec.check(t,
+		`POST /cursor {"query":"FOR d IN docs RETURN d"}`,
+		`DELETE /cursor/7`,
+		`POST /cursor {"query":"FOR d IN docs RETURN d"}`,
+		`DELETE /cursor/7`,
+	)
+
+	//the cursor is still deleted when ctx is done
+	rec.reset()
+	ctx, cancel := context.WithCancel(context.Background())
+	cur, _ = QueryTyped[DummyDocument](db, NewAqlQuery("FOR d IN docs RETURN d"))
+	cancel()
+
+	var last error
+	for _, err := range cur.AllCtx(ctx) {
+		last = err
+	}
+
+	if !errors.Is(last, context.Canceled) {
+		t.Fatalf("Expected the iteration to stop with the error of ctx but got %v", last)
+	}
+
+	rec.check(t,
+		`POST /cursor {"query":"FOR d IN docs RETURN d"}`,
+		`DELETE /cursor/7`,
+	)
+}
+
+func TestCursorStream(t *testing.T) {
+
+	prefetched := make(chan bool, 1)
+	deleted := make(chan string, 1)
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		switch r.Method {
+		case "POST":
+			id := "7"
+			if strings.Contains(string(b), "forever") {
+				id = "8"
+			} else if strings.Contains(string(b), "broken") {
+				id = "9"
+			}
+			w.WriteHeader(201)
+			fmt.Fprintf(w, `{"result":[{"Hi":"a"},{"Hi":"b"}],"hasMore":true,"id":"%s","error":false,"code":201}`, id)
+		case "PUT":
+			if strings.HasSuffix(r.URL.Path, "/8") {
+				fmt.Fprint(w, `{"result":[{"Hi":"more"}],"hasMore":true,"id":"8","error":false,"code":200}`)
+				return
+			}
+			if strings.HasSuffix(r.URL.Path, "/9") {
+				w.WriteHeader(500)
+				fmt.Fprint(w, `{"error":true,"code":500,"errorNum":4,"errorMessage":"out of memory"}`)
+				return
+			}
+			prefetched <- true
+			fmt.Fprint(w, `{"result":[{"Hi":"c"}],"hasMore":false,"id":"7","error":false,"code":200}`)
+		case "DELETE":
+			deleted <- strings.TrimPrefix(r.URL.Path, "/_db/_system/_api/cursor/")
+			w.WriteHeader(202)
+			fmt.Fprint(w, `{"id":"8","error":false,"code":202}`)
+		}
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cur, err := db.Query(NewAqlQuery("FOR d IN docs RETURN d"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, errs := cur.Stream(context.Background(), 0)
+
+	var got []string
+	for r := range results {
+		if len(got) == 0 {
+			//the next batch has to be on its way before
+			//we're done with the first one
+			select {
+			case <-prefetched:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Expected the next batch to be prefetched.")
+			}
+		}
+		var doc DummyDocument
+		json.Unmarshal(r, &doc)
+		got = append(got, doc.Hi)
+	}
+
+	if err = <-errs; err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(got) != "[a b c]" {
+		t.Fatalf("Expected every result in order but got %v", got)
+	}
+
+	cur, _ = db.Query(NewAqlQuery("FOR d IN forever RETURN d"))
+	typed := NewTypedCursor[DummyDocument](cur)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	docs, typedErrs := typed.Stream(ctx, 1)
+
+	if doc := <-docs; doc.Hi != "a" {
+		t.Fatalf("Expected the first document to be decoded but got %+v", doc)
+	}
+
+	cancel()
+
+	for range docs {
+	}
+
+	if err = <-typedErrs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the cancellation to be reported but got %v", err)
+	}
+
+	select {
+	case id := <-deleted:
+		if id != "8" {
+			t.Fatalf("Expected only the cancelled cursor to be deleted but got %s", id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the cursor to be deleted after cancelling.")
+	}
+
+	cur, _ = db.Query(NewAqlQuery("FOR d IN broken RETURN d"))
+	results, errs = cur.Stream(context.Background(), 0)
+
+	for range results {
+	}
+
+	if err = <-errs; err == nil || err.(ArangoError).Code != 500 {
+		t.Fatalf("Expected the failed fetch to be reported but got %v", err)
+	}
+
+	if id := <-deleted; id != "9" {
+		t.Fatalf("Expected the cursor to be deleted after the failed fetch but got %s", id)
+	}
+}
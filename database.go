@@ -1,11 +1,13 @@
 package arango
 
 import (
+	"context"
 	na "github.com/jmcvetta/napping"
 	//"log"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 //Database is an arango database connection.
@@ -15,18 +17,38 @@ import (
 //Do NOT instantiate this yourself. Use one
 //of the Conn/ConnDb/ConnDbUserPassword
 //methods instead.
+//
+//A Database is safe for concurrent use by multiple goroutines.
+//Request specific headers like If-Match are sent with a per request
+//copy of the session so concurrent calls never see each other's headers.
 type Database struct {
 	json *databaseResult
-	originalUrl *url.URL
+	//the options the connection was made with
+	options ConnOptions
+	//nil for unix socket connections
+	endpoints *endpointTransport
 	//holds addresses in form http://[username[:pass]@]localhost:8529
 	serverUrl *url.URL
 	session   *na.Session
 }
 
-//DatabaseOptions currently has nothing in it but is left as a
-//placeholder for future use when arangodb makes use of it
-type DatabaseOptions struct{}
+//DatabaseOptions are the options available when creating a database.
+//They only matter in a cluster and are ignored by servers that
+//don't support them. Leave a field blank to use the server default.
+type DatabaseOptions struct {
+	//Sharding is either "flexible" or "single".
+	Sharding string `json:"sharding,omitempty"`
+
+	//ReplicationFactor is the default for new collections in the database.
+	ReplicationFactor int `json:"replicationFactor,omitempty"`
+
+	//WriteConcern is the default number of copies that must be in sync
+	//for new collections in the database.
+	WriteConcern int `json:"writeConcern,omitempty"`
+}
 
+//User represents an arango user. It is used when creating databases
+//and by the user management methods in user.go.
 type User struct {
 	Username string      `json:"username"`
 	Passwd   string      `json:"passwd"`
@@ -63,20 +85,35 @@ func (db *Database) IsSystem() bool {
 //UseDatabase will switch databases as if
 //you called db._useDatabase in arangosh.
 //No error if successful, otherwise an error.
-//Under the hood it just makes another call to ConnDb
-//Using the same credentials used for the original database
-//and returns the results.
+//Under the hood it just makes another connection
+//using the same credentials and options used for the
+//original database and returns the results.
 func (db *Database) UseDatabase(databaseName string) (*Database, error) {
+	return db.UseDatabaseCtx(context.Background(), databaseName)
+}
+
+//UseDatabaseCtx is like UseDatabase but the request is bound to ctx.
+func (db *Database) UseDatabaseCtx(ctx context.Context, databaseName string) (*Database, error) {
 	//create a new connection instead of re-using the old
 	//object because re-use will cause collections
 	//that used the old object to break
-	return ConnDb(db.originalUrl.String(), databaseName)
+	if databaseName == "" {
+		return nil, newError("A blank database was specified but that is not allowed.")
+	}
+
+	options := db.options
+	options.Database = databaseName
+	//share the http client and endpoints so connections are
+	//pooled and endpoint health is known across databases
+	options.Client = db.session.Client
+	return connect(ctx, options, db.endpoints)
 }
 
 //Small internal type used while creating a database
 type createDatabase struct {
-	Name  string `json:"name"`
-	Users []User `json:"users"`
+	Name    string           `json:"name"`
+	Options *DatabaseOptions `json:"options,omitempty"`
+	Users   []User           `json:"users"`
 }
 
 type createDatabaseResult struct {
@@ -85,16 +122,23 @@ type createDatabaseResult struct {
 }
 
 //CreateDatabase creates a new database and is modeled after db._createDatabase
+//options can be nil.
 //users can be nil, or it can be a list of users you want created
 func (db *Database) CreateDatabase(name string, options *DatabaseOptions, users []User) error {
+	return db.CreateDatabaseCtx(context.Background(), name, options, users)
+}
+
+//CreateDatabaseCtx is like CreateDatabase but the request is bound to ctx.
+func (db *Database) CreateDatabaseCtx(ctx context.Context, name string, options *DatabaseOptions, users []User) error {
 
 	var result createDatabaseResult
 	var e ArangoError
 
-	response, err := db.session.Post(db.serverUrl.String()+"/database", &createDatabase{Name: name, Users: users}, &result, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(db.serverUrl.String()+"/database", &createDatabase{Name: name, Options: options, Users: users}, &result, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -113,16 +157,22 @@ type dropDatabaseResult struct {
 }
 
 func (db *Database) DropDatabase(name string) error {
+	return db.DropDatabaseCtx(context.Background(), name)
+}
+
+//DropDatabaseCtx is like DropDatabase but the request is bound to ctx.
+func (db *Database) DropDatabaseCtx(ctx context.Context, name string) error {
 
 	var result dropDatabaseResult
 	var e ArangoError
 
 	endpoint := fmt.Sprintf("%s/database/%s", db.serverUrl.String(), name)
 
-	response, err := db.session.Delete(endpoint, &result, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, &result, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -134,19 +184,79 @@ func (db *Database) DropDatabase(name string) error {
 
 }
 
+type databasesResult struct {
+	Result []string
+	ArangoError
+}
+
+//Databases returns the names of all databases on the server.
+//Arango only answers this from the _system database.
+//Uses the GET /_api/database endpoint.
+func (db *Database) Databases() ([]string, error) {
+	return db.DatabasesCtx(context.Background())
+}
+
+//DatabasesCtx is like Databases but the request is bound to ctx.
+func (db *Database) DatabasesCtx(ctx context.Context) ([]string, error) {
+	return db.databases(ctx, "database")
+}
+
+//UserDatabases returns the names of the databases the current user can access.
+//Uses the GET /_api/database/user endpoint.
+func (db *Database) UserDatabases() ([]string, error) {
+	return db.UserDatabasesCtx(context.Background())
+}
+
+//UserDatabasesCtx is like UserDatabases but the request is bound to ctx.
+func (db *Database) UserDatabasesCtx(ctx context.Context) ([]string, error) {
+	return db.databases(ctx, "database/user")
+}
+
+func (db *Database) databases(ctx context.Context, path string) ([]string, error) {
+
+	var result databasesResult
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/%s", db.serverUrl.String(), path)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, nil, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return result.Result, nil
+	default:
+		return nil, e
+	}
+}
+
 //Shortcut method for CreateCollection
 //that will use default options to create the document
 //collection.
 func (db *Database) CreateDocumentCollection(collectionName string) (*Collection, error) {
-	return db.CreateCollection(collectionName, DefaultCollectionOptions())
+	return db.CreateDocumentCollectionCtx(context.Background(), collectionName)
+}
+
+//CreateDocumentCollectionCtx is like CreateDocumentCollection but the request is bound to ctx.
+func (db *Database) CreateDocumentCollectionCtx(ctx context.Context, collectionName string) (*Collection, error) {
+	return db.CreateCollectionCtx(ctx, collectionName, DefaultCollectionOptions())
 }
 
 //Shortcut method for CreateCollection that will
 //use default options to create the edge collection
 func (db *Database) CreateEdgeCollection(collectionName string) (*Collection, error) {
+	return db.CreateEdgeCollectionCtx(context.Background(), collectionName)
+}
+
+//CreateEdgeCollectionCtx is like CreateEdgeCollection but the request is bound to ctx.
+func (db *Database) CreateEdgeCollectionCtx(ctx context.Context, collectionName string) (*Collection, error) {
 	options := DefaultCollectionOptions()
 	options.Type = EDGE_COLLECTION
-	return db.CreateCollection(collectionName, options)
+	return db.CreateCollectionCtx(ctx, collectionName, options)
 }
 
 //CreateCollection is the generic collection creating method. Use it for more control.
@@ -155,6 +265,11 @@ func (db *Database) CreateEdgeCollection(collectionName string) (*Collection, er
 //and you can use db.Collection( collectionName ) to get the collection
 //you just created and work with it.
 func (db *Database) CreateCollection(collectionName string, options CollectionCreationOptions) (*Collection, error) {
+	return db.CreateCollectionCtx(context.Background(), collectionName, options)
+}
+
+//CreateCollectionCtx is like CreateCollection but the request is bound to ctx.
+func (db *Database) CreateCollectionCtx(ctx context.Context, collectionName string, options CollectionCreationOptions) (*Collection, error) {
 
 	options.Name = collectionName
 	var e ArangoError
@@ -165,7 +280,8 @@ func (db *Database) CreateCollection(collectionName string, options CollectionCr
 
 	endpoint := fmt.Sprintf("%s/collection", db.serverUrl.String())
 
-	response, err := db.session.Post(endpoint, options, c.json, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, options, c.json, &e)
 
 	//fmt.Printf( "( %T, %+v )\n( %T, %+v )\n ( %T, %+v )\n",
 	//response,response,
@@ -173,7 +289,7 @@ func (db *Database) CreateCollection(collectionName string, options CollectionCr
 	//e, e )
 
 	if err != nil {
-		return nil, newError(err.Error())
+		return nil, wrapError(err)
 	}
 
 	switch response.Status() {
@@ -191,6 +307,11 @@ func (db *Database) CreateCollection(collectionName string, options CollectionCr
 //
 //Note : This method should not trigger the collection to be loaded
 func (db *Database) Collection(collectionName string) (*Collection, error) {
+	return db.CollectionCtx(context.Background(), collectionName)
+}
+
+//CollectionCtx is like Collection but the request is bound to ctx.
+func (db *Database) CollectionCtx(ctx context.Context, collectionName string) (*Collection, error) {
 	var c *Collection = new(Collection)
 	c.db = db
 	c.json = new(collectionResult)
@@ -198,14 +319,15 @@ func (db *Database) Collection(collectionName string) (*Collection, error) {
 	var e ArangoError
 
 	endpoint := fmt.Sprintf("%s/collection/%s", db.serverUrl.String(), collectionName)
-	response, err := db.session.Get(endpoint,
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint,
 		nil,
 		c.json,
-		e,
+		&e,
 	)
 
 	if err != nil {
-		return nil, newError(err.Error())
+		return nil, wrapError(err)
 	}
 
 	switch response.Status() {
@@ -218,6 +340,62 @@ func (db *Database) Collection(collectionName string) (*Collection, error) {
 	return nil, nil
 }
 
+type collectionsResult struct {
+	//Older servers list the collections here
+	Collections []*collectionResult
+	//and newer ones here
+	Result []*collectionResult
+	ArangoError
+}
+
+//Collections returns all collections in the database.
+//Pass true to leave out the system collections like _users.
+//Uses the GET /_api/collection endpoint.
+func (db *Database) Collections(excludeSystem bool) ([]*Collection, error) {
+	return db.CollectionsCtx(context.Background(), excludeSystem)
+}
+
+//CollectionsCtx is like Collections but the request is bound to ctx.
+func (db *Database) CollectionsCtx(ctx context.Context, excludeSystem bool) ([]*Collection, error) {
+
+	var query url.Values = make(url.Values)
+	query.Add("excludeSystem", fmt.Sprintf("%t", excludeSystem))
+
+	var result collectionsResult
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/collection", db.serverUrl.String())
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, &query, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+	default:
+		return nil, e
+	}
+
+	found := result.Collections
+	if len(found) == 0 {
+		found = result.Result
+	}
+
+	var collections = make([]*Collection, 0, len(found))
+
+	for _, r := range found {
+		//Older servers ignore excludeSystem
+		if excludeSystem && r.IsSystem {
+			continue
+		}
+		collections = append(collections, &Collection{db: db, json: r})
+	}
+
+	return collections, nil
+}
+
 type dropCollectionResult struct {
 	Id string
 	ArangoError
@@ -225,15 +403,21 @@ type dropCollectionResult struct {
 
 //DropCollection drops the collection in the database by name.
 func (db *Database) DropCollection(collectionName string) error {
+	return db.DropCollectionCtx(context.Background(), collectionName)
+}
+
+//DropCollectionCtx is like DropCollection but the request is bound to ctx.
+func (db *Database) DropCollectionCtx(ctx context.Context, collectionName string) error {
 
 	var result dropCollectionResult
 	var e ArangoError
 
 	endpoint := fmt.Sprintf("%s/collection/%s", db.serverUrl.String(), collectionName)
-	response, err := db.session.Delete(endpoint, &result, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, &result, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -249,6 +433,11 @@ func (db *Database) DropCollection(collectionName string) error {
 //Saves a document using the POST /_api/document endpoint.
 //Look at arango api docs for more info.
 func (db *Database) SaveDocumentWithOptions(document interface{}, options *SaveOptions) error {
+	return db.SaveDocumentWithOptionsCtx(context.Background(), document, options)
+}
+
+//SaveDocumentWithOptionsCtx is like SaveDocumentWithOptions but the request is bound to ctx.
+func (db *Database) SaveDocumentWithOptionsCtx(ctx context.Context, document interface{}, options *SaveOptions) error {
 
 	if options == nil {
 		return newError("You must provide save options when using the database.SaveWithOptions method.")
@@ -270,10 +459,11 @@ func (db *Database) SaveDocumentWithOptions(document interface{}, options *SaveO
 		values.Encode(),
 	)
 
-	response, err := db.session.Post(endpoint, document, document, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, document, document, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -286,11 +476,21 @@ func (db *Database) SaveDocumentWithOptions(document interface{}, options *SaveO
 
 //Document looks for a document in the database
 func (db *Database) Document(documentHandle interface{}, document interface{}) error {
-	return db.DocumentWithOptions(documentHandle, document, nil)
+	return db.DocumentCtx(context.Background(), documentHandle, document)
+}
+
+//DocumentCtx is like Document but the request is bound to ctx.
+func (db *Database) DocumentCtx(ctx context.Context, documentHandle interface{}, document interface{}) error {
+	return db.DocumentWithOptionsCtx(ctx, documentHandle, document, nil)
 }
 
 //DocumentWithOptions looks for a document in the database
 func (db *Database) DocumentWithOptions(documentHandle interface{}, document interface{}, options *GetOptions) error {
+	return db.DocumentWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//DocumentWithOptionsCtx is like DocumentWithOptions but the request is bound to ctx.
+func (db *Database) DocumentWithOptionsCtx(ctx context.Context, documentHandle interface{}, document interface{}, options *GetOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -309,24 +509,22 @@ func (db *Database) DocumentWithOptions(documentHandle interface{}, document int
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = &GetOptions{}
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
 	}
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfNoneMatch != "" {
-			db.session.Header.Add("If-None-Match", options.IfNoneMatch)
-			defer func() { db.session.Header.Del("If-None-Match") }()
+			header.Set("If-None-Match", options.IfNoneMatch)
 		}
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 	}
 
@@ -334,10 +532,11 @@ func (db *Database) DocumentWithOptions(documentHandle interface{}, document int
 
 	endpoint := fmt.Sprintf("%s/document/%s", db.serverUrl.String(), id)
 
-	response, err := db.session.Get(endpoint, nil, document, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Get(endpoint, nil, document, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -349,6 +548,11 @@ func (db *Database) DocumentWithOptions(documentHandle interface{}, document int
 }
 
 func (db *Database) ReplaceDocumentWithOptions(documentHandle, document interface{}, options *ReplaceOptions) error {
+	return db.ReplaceDocumentWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//ReplaceDocumentWithOptionsCtx is like ReplaceDocumentWithOptions but the request is bound to ctx.
+func (db *Database) ReplaceDocumentWithOptionsCtx(ctx context.Context, documentHandle, document interface{}, options *ReplaceOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -367,6 +571,9 @@ func (db *Database) ReplaceDocumentWithOptions(documentHandle, document interfac
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultReplaceOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -374,15 +581,11 @@ func (db *Database) ReplaceDocumentWithOptions(documentHandle, document interfac
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -400,10 +603,11 @@ func (db *Database) ReplaceDocumentWithOptions(documentHandle, document interfac
 
 	endpoint := fmt.Sprintf("%s/document/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Put(endpoint, document, document, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Put(endpoint, document, document, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -417,6 +621,11 @@ func (db *Database) ReplaceDocumentWithOptions(documentHandle, document interfac
 }
 
 func (db *Database) UpdateDocumentWithOptions(documentHandle, document interface{}, options *UpdateOptions) error {
+	return db.UpdateDocumentWithOptionsCtx(context.Background(), documentHandle, document, options)
+}
+
+//UpdateDocumentWithOptionsCtx is like UpdateDocumentWithOptions but the request is bound to ctx.
+func (db *Database) UpdateDocumentWithOptionsCtx(ctx context.Context, documentHandle, document interface{}, options *UpdateOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -435,6 +644,9 @@ func (db *Database) UpdateDocumentWithOptions(documentHandle, document interface
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultUpdateOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -442,15 +654,11 @@ func (db *Database) UpdateDocumentWithOptions(documentHandle, document interface
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -470,10 +678,11 @@ func (db *Database) UpdateDocumentWithOptions(documentHandle, document interface
 
 	endpoint := fmt.Sprintf("%s/document/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Patch(endpoint, document, document, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Patch(endpoint, document, document, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -487,6 +696,11 @@ func (db *Database) UpdateDocumentWithOptions(documentHandle, document interface
 }
 
 func (db *Database) DeleteDocumentWithOptions(documentHandle interface{}, options *DeleteOptions) error {
+	return db.DeleteDocumentWithOptionsCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteDocumentWithOptionsCtx is like DeleteDocumentWithOptions but the request is bound to ctx.
+func (db *Database) DeleteDocumentWithOptionsCtx(ctx context.Context, documentHandle interface{}, options *DeleteOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -505,6 +719,9 @@ func (db *Database) DeleteDocumentWithOptions(documentHandle interface{}, option
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultDeleteOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -512,15 +729,11 @@ func (db *Database) DeleteDocumentWithOptions(documentHandle interface{}, option
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -538,10 +751,11 @@ func (db *Database) DeleteDocumentWithOptions(documentHandle interface{}, option
 
 	endpoint := fmt.Sprintf("%s/document/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Delete(endpoint, &struct{}{}, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Delete(endpoint, &struct{}{}, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -555,6 +769,11 @@ func (db *Database) DeleteDocumentWithOptions(documentHandle interface{}, option
 }
 
 func (db *Database) SaveEdgeWithOptions(from, to, edge interface{}, options *SaveOptions) error {
+	return db.SaveEdgeWithOptionsCtx(context.Background(), from, to, edge, options)
+}
+
+//SaveEdgeWithOptionsCtx is like SaveEdgeWithOptions but the request is bound to ctx.
+func (db *Database) SaveEdgeWithOptionsCtx(ctx context.Context, from, to, edge interface{}, options *SaveOptions) error {
 
 	if options == nil {
 		return newError("You must provide save options when using the database.SaveWithOptions method.")
@@ -602,10 +821,11 @@ func (db *Database) SaveEdgeWithOptions(from, to, edge interface{}, options *Sav
 		values.Encode(),
 	)
 
-	response, err := db.session.Post(endpoint, edge, edge, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, edge, edge, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -622,11 +842,21 @@ func (db *Database) SaveEdgeWithOptions(from, to, edge interface{}, options *Sav
 
 //Edge retrieves an edge in the database
 func (db *Database) Edge(documentHandle, edge interface{}) error {
-	return db.EdgeWithOptions(documentHandle, edge, nil)
+	return db.EdgeCtx(context.Background(), documentHandle, edge)
+}
+
+//EdgeCtx is like Edge but the request is bound to ctx.
+func (db *Database) EdgeCtx(ctx context.Context, documentHandle, edge interface{}) error {
+	return db.EdgeWithOptionsCtx(ctx, documentHandle, edge, nil)
 }
 
 //EdgeWithOptions retrieves an edge in the database
 func (db *Database) EdgeWithOptions(documentHandle interface{}, edge interface{}, options *GetOptions) error {
+	return db.EdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//EdgeWithOptionsCtx is like EdgeWithOptions but the request is bound to ctx.
+func (db *Database) EdgeWithOptionsCtx(ctx context.Context, documentHandle interface{}, edge interface{}, options *GetOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -645,24 +875,22 @@ func (db *Database) EdgeWithOptions(documentHandle interface{}, edge interface{}
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = &GetOptions{}
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
 	}
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfNoneMatch != "" {
-			db.session.Header.Add("If-None-Match", options.IfNoneMatch)
-			defer func() { db.session.Header.Del("If-None-Match") }()
+			header.Set("If-None-Match", options.IfNoneMatch)
 		}
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 	}
 
@@ -670,10 +898,11 @@ func (db *Database) EdgeWithOptions(documentHandle interface{}, edge interface{}
 
 	endpoint := fmt.Sprintf("%s/edge/%s", db.serverUrl.String(), id)
 
-	response, err := db.session.Get(endpoint, nil, edge, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Get(endpoint, nil, edge, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -686,6 +915,11 @@ func (db *Database) EdgeWithOptions(documentHandle interface{}, edge interface{}
 
 //ReplaceEdgeWithOptions
 func (db *Database) ReplaceEdgeWithOptions(documentHandle, edge interface{}, options *ReplaceOptions) error {
+	return db.ReplaceEdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//ReplaceEdgeWithOptionsCtx is like ReplaceEdgeWithOptions but the request is bound to ctx.
+func (db *Database) ReplaceEdgeWithOptionsCtx(ctx context.Context, documentHandle, edge interface{}, options *ReplaceOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -704,6 +938,9 @@ func (db *Database) ReplaceEdgeWithOptions(documentHandle, edge interface{}, opt
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultReplaceOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -711,15 +948,11 @@ func (db *Database) ReplaceEdgeWithOptions(documentHandle, edge interface{}, opt
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -737,10 +970,11 @@ func (db *Database) ReplaceEdgeWithOptions(documentHandle, edge interface{}, opt
 
 	endpoint := fmt.Sprintf("%s/edge/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Put(endpoint, edge, edge, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Put(endpoint, edge, edge, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -754,6 +988,11 @@ func (db *Database) ReplaceEdgeWithOptions(documentHandle, edge interface{}, opt
 }
 
 func (db *Database) UpdateEdgeWithOptions(documentHandle, edge interface{}, options *UpdateOptions) error {
+	return db.UpdateEdgeWithOptionsCtx(context.Background(), documentHandle, edge, options)
+}
+
+//UpdateEdgeWithOptionsCtx is like UpdateEdgeWithOptions but the request is bound to ctx.
+func (db *Database) UpdateEdgeWithOptionsCtx(ctx context.Context, documentHandle, edge interface{}, options *UpdateOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -772,6 +1011,9 @@ func (db *Database) UpdateEdgeWithOptions(documentHandle, edge interface{}, opti
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultUpdateOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -779,15 +1021,11 @@ func (db *Database) UpdateEdgeWithOptions(documentHandle, edge interface{}, opti
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -807,10 +1045,11 @@ func (db *Database) UpdateEdgeWithOptions(documentHandle, edge interface{}, opti
 
 	endpoint := fmt.Sprintf("%s/edge/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Patch(endpoint, edge, edge, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Patch(endpoint, edge, edge, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -824,6 +1063,11 @@ func (db *Database) UpdateEdgeWithOptions(documentHandle, edge interface{}, opti
 }
 
 func (db *Database) DeleteEdgeWithOptions(documentHandle interface{}, options *DeleteOptions) error {
+	return db.DeleteEdgeWithOptionsCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteEdgeWithOptionsCtx is like DeleteEdgeWithOptions but the request is bound to ctx.
+func (db *Database) DeleteEdgeWithOptionsCtx(ctx context.Context, documentHandle interface{}, options *DeleteOptions) error {
 
 	var id string
 	switch dh := documentHandle.(type) {
@@ -842,6 +1086,9 @@ func (db *Database) DeleteEdgeWithOptions(documentHandle interface{}, options *D
 	if rev, ok := documentHandle.(HasArangoRev); ok {
 		if options == nil {
 			options = DefaultDeleteOptions()
+		} else {
+			copied := *options
+			options = &copied
 		}
 
 		options.IfMatch = rev.Rev()
@@ -849,15 +1096,11 @@ func (db *Database) DeleteEdgeWithOptions(documentHandle interface{}, options *D
 
 	var query url.Values = make(url.Values)
 
-	if options != nil {
-		if db.session.Header == nil {
-			db.session.Header = &http.Header{}
-			defer func() { db.session.Header = nil }()
-		}
+	var header = make(http.Header)
 
+	if options != nil {
 		if options.IfMatch != "" {
-			db.session.Header.Add("If-Match", options.IfMatch)
-			defer func() { db.session.Header.Del("If-Match") }()
+			header.Set("If-Match", options.IfMatch)
 		}
 
 		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
@@ -875,10 +1118,11 @@ func (db *Database) DeleteEdgeWithOptions(documentHandle interface{}, options *D
 
 	endpoint := fmt.Sprintf("%s/edge/%s?%s", db.serverUrl.String(), id, query.Encode())
 
-	response, err := db.session.Delete(endpoint, &struct{}{}, &e)
+	session := db.sessionWithHeader(ctx, header)
+	response, err := session.Delete(endpoint, &struct{}{}, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -890,3 +1134,100 @@ func (db *Database) DeleteEdgeWithOptions(documentHandle interface{}, options *D
 
 	return nil
 }
+
+//Edge directions used by Edges
+const (
+	DIRECTION_ANY = "any"
+	DIRECTION_IN  = "in"
+	DIRECTION_OUT = "out"
+)
+
+type edgesResult struct {
+	Edges interface{} `json:"edges"`
+}
+
+//EdgesOptions are the options of Edges.
+type EdgesOptions struct {
+	//VertexCollection is the collection the vertex lives in. It
+	//is needed when the vertex is only a key, like "1" or a
+	//document implementing HasArangoKey without an id.
+	VertexCollection string
+}
+
+//Edges fetches the edges in collectionName that start or end at vertex
+//using the GET /_api/edges/{collection-name} endpoint.
+//vertex can be a string, a HasArangoId or a HasArangoKey. A key is
+//turned into an id with options.VertexCollection because the vertex
+//usually lives in another collection than the edges. direction is one
+//of DIRECTION_ANY, DIRECTION_IN or DIRECTION_OUT. Blank means any.
+//edges should point to a slice, like *[]EdgeImplementation.
+//Options can be nil when vertex is a full id.
+func (db *Database) Edges(collectionName string, vertex interface{}, direction string, edges interface{}, options *EdgesOptions) error {
+	return db.EdgesCtx(context.Background(), collectionName, vertex, direction, edges, options)
+}
+
+//EdgesCtx is like Edges but the request is bound to ctx.
+func (db *Database) EdgesCtx(ctx context.Context, collectionName string, vertex interface{}, direction string, edges interface{}, options *EdgesOptions) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when fetching edges.")
+	}
+
+	if options == nil {
+		options = &EdgesOptions{}
+	}
+
+	var id string
+	switch v := vertex.(type) {
+	case string:
+		id = v
+	case HasArangoId:
+		id = v.Id()
+		if key, ok := vertex.(HasArangoKey); ok && id == "" {
+			id = key.Key()
+		}
+	case HasArangoKey:
+		id = v.Key()
+	default:
+		return newError("The vertex must be a valid document handle. (It must be a string or implement HasArangoId or HasArangoKey)")
+	}
+
+	if id != "" && !strings.Contains(id, "/") && options.VertexCollection != "" {
+		id = options.VertexCollection + "/" + id
+	}
+
+	if !strings.Contains(id, "/") {
+		return newError("The vertex must be a full document id like collection/key or options.VertexCollection must be set when fetching edges.")
+	}
+
+	switch direction {
+	case "":
+		direction = DIRECTION_ANY
+	case DIRECTION_ANY, DIRECTION_IN, DIRECTION_OUT:
+	default:
+		return newError(fmt.Sprintf("Unknown edge direction %q.", direction))
+	}
+
+	var query url.Values = make(url.Values)
+	query.Add("vertex", id)
+	query.Add("direction", direction)
+
+	var result = edgesResult{Edges: edges}
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/edges/%s", db.serverUrl.String(), collectionName)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, &query, &result, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
@@ -1,7 +1,13 @@
 package arango
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 	//"log"
 )
 
@@ -546,3 +552,217 @@ func TestDatabaseEdgeMethods(t *testing.T) {
 	}
 
 }
+
+func TestDatabaseContextCancellation(t *testing.T) {
+
+	release := make(chan struct{})
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var doc struct{ Hi string }
+	err = db.DocumentCtx(ctx, "testing/1", &doc)
+
+	if err == nil {
+		t.Fatal("Expected an error because the context deadline passed.")
+	}
+
+	if ctx.Err() == nil {
+		t.Fatal("Expected the request to only stop after the context was done.")
+	}
+
+	//An already canceled context should not even reach the server
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	_, err = db.CollectionCtx(ctx, "testing")
+
+	if err == nil {
+		t.Fatal("Expected an error because the context was canceled.")
+	}
+}
+
+func TestDatabaseConcurrentRevisionHeaders(t *testing.T) {
+
+	//The fake server expects the If-Match header of every request
+	//to equal the key of the document being requested.
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if r.Header.Get("If-Match") != key || len(r.Header["If-Match"]) != 1 {
+			w.WriteHeader(412)
+			fmt.Fprintf(w, `{"error":true,"code":412,"errorNum":1200,"errorMessage":"got %v for %s"}`, r.Header["If-Match"], key)
+			return
+		}
+		fmt.Fprintf(w, `{"_id":"testing/%s","_key":"%s","_rev":"%s"}`, key, key, key)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shared := &GetOptions{}
+	errs := make(chan error, 200)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("%d", i)
+			for j := 0; j < 4; j++ {
+				var doc DocumentImplementation
+				var err error
+				switch j {
+				case 0:
+					err = db.DocumentWithOptions("testing/"+key, &doc, &GetOptions{IfMatch: key})
+				case 1:
+					//the revision comes from the handle and the shared
+					//options must not be touched
+					err = db.DocumentWithOptions(&DocumentImplementation{ArangoId: "testing/" + key, ArangoRev: key}, &doc, shared)
+				case 2:
+					err = db.ReplaceDocumentWithOptions("testing/"+key, &doc, &ReplaceOptions{IfMatch: key})
+				case 3:
+					err = db.DeleteEdgeWithOptions("testing/"+key, &DeleteOptions{IfMatch: key})
+				}
+				if err != nil {
+					errs <- err
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if shared.IfMatch != "" {
+		t.Fatal("The caller's options should not have been modified.")
+	}
+}
+
+func TestDatabaseListing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	system, err := Conn("http://root@localhost:8529")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := system.Databases()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, name := range names {
+		found = found || name == "testing"
+	}
+
+	if !found {
+		t.Fatalf("Expected the testing database to be listed: %v", names)
+	}
+
+	if names, err = db.UserDatabases(); err != nil || len(names) == 0 {
+		t.Fatalf("Expected the user databases to be listed: %v %v", names, err)
+	}
+
+	if _, err = db.CreateDocumentCollection("listed"); err != nil {
+		t.Fatal(err)
+	}
+
+	collections, err := db.Collections(true)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range collections {
+		if c.IsSystem() {
+			t.Fatalf("Expected system collections to be excluded but got %s", c.Name())
+		}
+	}
+
+	all, err := db.Collections(false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all) <= len(collections) {
+		t.Fatalf("Expected the system collections to be included: %d <= %d", len(all), len(collections))
+	}
+}
+
+func TestDatabaseListingRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_db/_system/_api/collection":
+			fmt.Fprint(w, `{"collections":[{"id":"1","name":"_users","isSystem":true,"status":3,"type":2},{"id":"2","name":"things","status":3,"type":3}],"error":false,"code":200}`)
+		case "/_db/_system/_api/database":
+			fmt.Fprint(w, `{"result":["_system","testing"],"error":false,"code":200}`)
+		case "/_db/_system/_api/database/user":
+			fmt.Fprint(w, `{"result":["_system"],"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collections, err := db.Collections(true)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(collections) != 1 || collections[0].Name() != "things" || collections[0].Type() != EDGE_COLLECTION {
+		t.Fatalf("Expected only the things collection: %v", collections)
+	}
+
+	if all, err := db.Collections(false); err != nil || len(all) != 2 {
+		t.Fatalf("Expected both collections: %v %v", all, err)
+	}
+
+	names, err := db.Databases()
+
+	if err != nil || fmt.Sprint(names) != "[_system testing]" {
+		t.Fatalf("Unexpected databases %v %v", names, err)
+	}
+
+	names, err = db.UserDatabases()
+
+	if err != nil || fmt.Sprint(names) != "[_system]" {
+		t.Fatalf("Unexpected user databases %v %v", names, err)
+	}
+
+	rec.check(t,
+		`GET /collection?excludeSystem=true`,
+		`GET /collection?excludeSystem=false`,
+		`GET /database`,
+		`GET /database/user`,
+	)
+}
@@ -0,0 +1,276 @@
+package arango
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+//A Database can be given several endpoints with ConnOptions.Endpoints.
+//Every request is sent to the first healthy endpoint, or when
+//ConnOptions.RoundRobin is set, reads are spread across all healthy
+//endpoints.
+//
+//An endpoint is marked as unhealthy when it can't be reached or it
+//answers with 503 Service Unavailable. The request is then retried on
+//the next endpoint if that is safe:
+//  * the connection could not be established so nothing was sent
+//  * or the request is idempotent (GET, HEAD, OPTIONS, PUT, DELETE)
+//    and isn't a PUT /_api/cursor/{id} which moves a cursor forward
+//    on the server that created it.
+//Unhealthy endpoints are tried again after ConnOptions.EndpointRetryInterval
+//or as soon as CheckEndpoints finds them healthy.
+
+//DefaultEndpointRetryInterval is used when
+//ConnOptions.EndpointRetryInterval is 0.
+const DefaultEndpointRetryInterval = 5 * time.Second
+
+//EndpointStatus is the result of a health check of one endpoint.
+type EndpointStatus struct {
+	Endpoint string
+	Healthy  bool
+	//Error is why the endpoint is unhealthy. It is nil when healthy.
+	Error error
+}
+
+type endpoint struct {
+	url       *url.URL
+	downUntil time.Time
+}
+
+//endpointTransport sends requests to one of several endpoints
+//by rewriting the scheme and host of each request.
+type endpointTransport struct {
+	base          http.RoundTripper
+	roundRobin    bool
+	retryInterval time.Duration
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+	next      int
+}
+
+func newEndpointTransport(host *url.URL, options *ConnOptions) (*endpointTransport, error) {
+
+	t := &endpointTransport{
+		roundRobin:    options.RoundRobin,
+		retryInterval: options.EndpointRetryInterval,
+	}
+
+	if t.retryInterval == 0 {
+		t.retryInterval = DefaultEndpointRetryInterval
+	}
+
+	seen := make(map[string]bool)
+	add := func(u *url.URL) {
+		key := u.Scheme + "://" + u.Host
+		if !seen[key] {
+			seen[key] = true
+			t.endpoints = append(t.endpoints, &endpoint{url: &url.URL{Scheme: u.Scheme, Host: u.Host}})
+		}
+	}
+
+	add(host)
+	for _, e := range options.Endpoints {
+		u, err := url.Parse(e)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, newError("Endpoints must use the http or https scheme: " + e)
+		}
+		add(u)
+	}
+
+	return t, nil
+}
+
+//order returns the endpoints to try for a request, healthy ones first.
+func (t *endpointTransport) order(method string) []*endpoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	var healthy, down []*endpoint
+	for _, e := range t.endpoints {
+		if now.Before(e.downUntil) {
+			down = append(down, e)
+		} else {
+			healthy = append(healthy, e)
+		}
+	}
+
+	if t.roundRobin && isRead(method) && len(healthy) > 1 {
+		start := t.next % len(healthy)
+		t.next++
+		healthy = append(healthy[start:], healthy[:start]...)
+	}
+
+	return append(healthy, down...)
+}
+
+func (t *endpointTransport) mark(e *endpoint, healthy bool) {
+	t.mu.Lock()
+	if healthy {
+		e.downUntil = time.Time{}
+	} else {
+		e.downUntil = time.Now().Add(t.retryInterval)
+	}
+	t.mu.Unlock()
+}
+
+func (t *endpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	order := t.order(req.Method)
+
+	for i, e := range order {
+		r := req.Clone(req.Context())
+		r.URL.Scheme = e.url.Scheme
+		r.URL.Host = e.url.Host
+		r.Host = e.url.Host
+
+		//the transport closes the body even when the dial fails,
+		//so canRetry made sure GetBody is there to get a new one
+		if i > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+
+		if err == nil && resp.StatusCode != http.StatusServiceUnavailable {
+			t.mark(e, true)
+			return resp, nil
+		}
+
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		t.mark(e, false)
+
+		last := i == len(order)-1
+		if last || !canRetry(req, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+
+	return nil, errors.New("arango: no endpoints to send the request to")
+}
+
+//check asks every endpoint for its version to find out if it is up.
+func (t *endpointTransport) check(ctx context.Context) []EndpointStatus {
+
+	t.mu.Lock()
+	endpoints := append([]*endpoint(nil), t.endpoints...)
+	t.mu.Unlock()
+
+	statuses := make([]EndpointStatus, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+
+			status := EndpointStatus{Endpoint: e.url.String()}
+
+			req, err := http.NewRequestWithContext(ctx, "GET", e.url.String()+"/_api/version", nil)
+			if err == nil {
+				var resp *http.Response
+				resp, err = t.base.RoundTrip(req)
+				if err == nil {
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+					//any answer, even 401, means the server is up
+					if resp.StatusCode == http.StatusServiceUnavailable {
+						err = errors.New(resp.Status)
+					}
+				}
+			}
+
+			status.Healthy = err == nil
+			status.Error = err
+			t.mark(e, status.Healthy)
+			statuses[i] = status
+		}(i, e)
+	}
+	wg.Wait()
+
+	return statuses
+}
+
+func isRead(method string) bool {
+	return method == "GET" || method == "HEAD" || method == "OPTIONS"
+}
+
+//canRetry reports if req can be sent again to another endpoint
+//after it failed with err, which is nil if the server answered 503.
+func canRetry(req *http.Request, err error) bool {
+
+	//a body that can't be rewound is closed once it was handed
+	//to the transport, even if it never left this process
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	var opErr *net.OpError
+	if err != nil && errors.As(err, &opErr) && opErr.Op == "dial" {
+		//nothing reached the server
+		return true
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "DELETE":
+		return true
+	case "PUT":
+		return !strings.Contains(req.URL.Path, "/_api/cursor/")
+	}
+
+	return false
+}
+
+//Endpoints returns the endpoints this database sends requests to.
+func (db *Database) Endpoints() []string {
+	if db.endpoints == nil {
+		return []string{db.options.Host}
+	}
+
+	db.endpoints.mu.Lock()
+	defer db.endpoints.mu.Unlock()
+
+	var endpoints []string
+	for _, e := range db.endpoints.endpoints {
+		endpoints = append(endpoints, e.url.String())
+	}
+	return endpoints
+}
+
+//CheckEndpoints checks the health of every endpoint by calling
+//GET /_api/version on each of them. Endpoints found healthy
+//are used again right away.
+func (db *Database) CheckEndpoints() []EndpointStatus {
+	return db.CheckEndpointsCtx(context.Background())
+}
+
+//CheckEndpointsCtx is like CheckEndpoints but the requests are bound to ctx.
+func (db *Database) CheckEndpointsCtx(ctx context.Context) []EndpointStatus {
+	if db.endpoints == nil {
+		return nil
+	}
+	return db.endpoints.check(ctx)
+}
@@ -0,0 +1,257 @@
+package arango
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//flakyServer is a fake arango server that can be switched into
+//answering every request with 503 and counts the requests it served.
+type flakyServer struct {
+	*httptest.Server
+	down   int32
+	served int32
+}
+
+func newFlakyServer() *flakyServer {
+	s := &flakyServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&s.down) == 1 {
+			w.WriteHeader(503)
+			fmt.Fprint(w, `{"error":true,"code":503,"errorNum":503,"errorMessage":"service unavailable"}`)
+			return
+		}
+		atomic.AddInt32(&s.served, 1)
+		fakeHandler(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"id":"1","name":"testing","status":3,"type":2,"_id":"testing/1","_key":"1","_rev":"1"}`)
+		})(w, r)
+	}))
+	return s
+}
+
+func (s *flakyServer) setDown(down bool) {
+	if down {
+		atomic.StoreInt32(&s.down, 1)
+	} else {
+		atomic.StoreInt32(&s.down, 0)
+	}
+}
+
+func (s *flakyServer) count() int32 {
+	return atomic.LoadInt32(&s.served)
+}
+
+func TestEndpointFailover(t *testing.T) {
+	primary := newFlakyServer()
+	secondary := newFlakyServer()
+	defer secondary.Close()
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Endpoints: []string{primary.URL, secondary.URL},
+		//without keep-alive a closed server shows up as a dial error
+		Transport: &http.Transport{DisableKeepAlives: true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(db.Endpoints()) != 2 {
+		t.Fatalf("Expected 2 endpoints but got %v", db.Endpoints())
+	}
+
+	_, err = db.Collection("testing")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if primary.count() != 2 || secondary.count() != 0 {
+		t.Fatal("Expected every request to go to the first endpoint while it is healthy.")
+	}
+
+	//A write can be retried when the server can't even be reached
+	primary.Close()
+
+	err = db.SaveDocumentWithOptions(&DocumentImplementation{}, &SaveOptions{Collection: "testing"})
+
+	if err != nil {
+		t.Fatal("Expected the save to fail over to the second endpoint.", err)
+	}
+
+	if secondary.count() != 1 {
+		t.Fatal("Expected the second endpoint to handle the request.")
+	}
+
+	statuses := db.CheckEndpoints()
+
+	if len(statuses) != 2 || statuses[0].Healthy || !statuses[1].Healthy {
+		t.Fatalf("Expected only the second endpoint to be healthy: %+v", statuses)
+	}
+
+	//switching databases keeps the endpoints
+	other, err := db.UseDatabase("other")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(other.Endpoints()) != 2 {
+		t.Fatalf("Expected 2 endpoints but got %v", other.Endpoints())
+	}
+}
+
+func TestEndpointStreamedBody(t *testing.T) {
+	primary := newFlakyServer()
+	secondary := newFlakyServer()
+	defer secondary.Close()
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Endpoints: []string{primary.URL, secondary.URL},
+		Transport: &http.Transport{DisableKeepAlives: true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	primary.Close()
+
+	//a body without GetBody is gone once the first endpoint was tried
+	req, _ := http.NewRequest("POST", primary.URL+"/_api/document?collection=testing", io.NopCloser(strings.NewReader("{}")))
+	_, err = db.endpoints.RoundTrip(req)
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "dial" {
+		t.Fatalf("Expected the dial error of the first endpoint but got %v", err)
+	}
+
+	if secondary.count() != 0 {
+		t.Fatal("Expected a streamed body not to be sent to the second endpoint.")
+	}
+
+	//a body that can be rewound still fails over
+	db.endpoints.mark(db.endpoints.endpoints[0], true)
+	req, _ = http.NewRequest("POST", primary.URL+"/_api/document?collection=testing", strings.NewReader("{}"))
+	resp, err := db.endpoints.RoundTrip(req)
+
+	if err != nil {
+		t.Fatal("Expected the request to fail over to the second endpoint.", err)
+	}
+	resp.Body.Close()
+
+	if secondary.count() != 1 {
+		t.Fatal("Expected the second endpoint to handle the request.")
+	}
+}
+
+func TestEndpointServiceUnavailable(t *testing.T) {
+	primary := newFlakyServer()
+	secondary := newFlakyServer()
+	defer primary.Close()
+	defer secondary.Close()
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Host:                  primary.URL,
+		Endpoints:             []string{secondary.URL},
+		EndpointRetryInterval: 20 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	primary.setDown(true)
+
+	//A POST that was answered with 503 is not retried
+	err = db.SaveDocumentWithOptions(&DocumentImplementation{}, &SaveOptions{Collection: "testing"})
+
+	if err == nil {
+		t.Fatal("Expected the save to fail because writes are not retried after a 503.")
+	}
+
+	//but the endpoint is now skipped
+	err = db.SaveDocumentWithOptions(&DocumentImplementation{}, &SaveOptions{Collection: "testing"})
+
+	if err != nil {
+		t.Fatal("Expected the save to go to the healthy endpoint.", err)
+	}
+
+	//reads are retried right away
+	time.Sleep(30 * time.Millisecond)
+
+	var doc DocumentImplementation
+	err = db.Document("testing/1", &doc)
+
+	if err != nil {
+		t.Fatal("Expected the read to be retried on the second endpoint.", err)
+	}
+
+	if secondary.count() != 2 {
+		t.Fatalf("Expected 2 requests on the second endpoint but got %d", secondary.count())
+	}
+
+	//once the primary is back it is used again after the retry interval
+	primary.setDown(false)
+	time.Sleep(30 * time.Millisecond)
+	before := primary.count()
+
+	err = db.Document("testing/1", &doc)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if primary.count() != before+1 {
+		t.Fatal("Expected the first endpoint to be used again once it recovered.")
+	}
+}
+
+func TestEndpointRoundRobin(t *testing.T) {
+	servers := []*flakyServer{newFlakyServer(), newFlakyServer(), newFlakyServer()}
+	var urls []string
+	for _, s := range servers {
+		defer s.Close()
+		urls = append(urls, s.URL)
+	}
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Endpoints:  urls,
+		RoundRobin: true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 8; i++ {
+		var doc DocumentImplementation
+		if err := db.Document("testing/1", &doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//the connection check plus 8 reads
+	for i, s := range servers {
+		if s.count() != 3 {
+			t.Fatalf("Expected reads to be spread evenly but server %d served %d", i, s.count())
+		}
+	}
+
+	//writes always go to the first endpoint
+	before := servers[0].count()
+	db.SaveDocumentWithOptions(&DocumentImplementation{}, &SaveOptions{Collection: "testing"})
+	db.SaveDocumentWithOptions(&DocumentImplementation{}, &SaveOptions{Collection: "testing"})
+
+	if servers[0].count() != before+2 {
+		t.Fatal("Expected writes to go to the first healthy endpoint.")
+	}
+}
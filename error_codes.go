@@ -0,0 +1,854 @@
+// Code generated by gen_errors.go from arangodb's lib/Basics/errors.dat. DO NOT EDIT.
+
+package arango
+
+// Error numbers arangodb returns in the errorNum field of its responses.
+// Compare them with ArangoError.ErrorNum or use ErrorName to print them.
+const (
+	//No error has occurred.
+	ERROR_NO_ERROR = 0
+	//Will be raised when a general error occurred.
+	ERROR_FAILED = 1
+	//Will be raised when operating system error occurred.
+	ERROR_SYS_ERROR = 2
+	//Will be raised when there is a memory shortage.
+	ERROR_OUT_OF_MEMORY = 3
+	//Will be raised when an internal error occurred.
+	ERROR_INTERNAL = 4
+	//Will be raised when an illegal representation of a number was given.
+	ERROR_ILLEGAL_NUMBER = 5
+	//Will be raised when a numeric overflow occurred.
+	ERROR_NUMERIC_OVERFLOW = 6
+	//Will be raised when an unknown option was supplied by the user.
+	ERROR_ILLEGAL_OPTION = 7
+	//Will be raised when a PID without a living process was found.
+	ERROR_DEAD_PID = 8
+	//Will be raised when hitting an unimplemented feature.
+	ERROR_NOT_IMPLEMENTED = 9
+	//Will be raised when the parameter does not fulfill the requirements.
+	ERROR_BAD_PARAMETER = 10
+	//Will be raised when you are missing permission for the operation.
+	ERROR_FORBIDDEN = 11
+	//Will be raised when there is a memory shortage.
+	ERROR_OUT_OF_MEMORY_MMAP = 12
+	//Will be raised when encountering a corrupt csv line.
+	ERROR_CORRUPTED_CSV = 13
+	//Will be raised when a file is not found.
+	ERROR_FILE_NOT_FOUND = 14
+	//Will be raised when a file cannot be written.
+	ERROR_CANNOT_WRITE_FILE = 15
+	//Will be raised when an attempt is made to overwrite an existing file.
+	ERROR_CANNOT_OVERWRITE_FILE = 16
+	//Will be raised when a type error is unencountered.
+	ERROR_TYPE_ERROR = 17
+	//Will be raised when there's a timeout waiting for a lock.
+	ERROR_LOCK_TIMEOUT = 18
+	//Will be raised when an attempt to create a directory fails.
+	ERROR_CANNOT_CREATE_DIRECTORY = 19
+	//Will be raised when an attempt to create a temporary file fails.
+	ERROR_CANNOT_CREATE_TEMP_FILE = 20
+	//Will be raised when a request is canceled by the user.
+	ERROR_REQUEST_CANCELED = 21
+	//Will be raised intentionally during debugging.
+	ERROR_DEBUG = 22
+	//Will be raised when the structure of an IP address is invalid.
+	ERROR_IP_ADDRESS_INVALID = 25
+	//Will be raised when a file already exists.
+	ERROR_FILE_EXISTS = 27
+	//Will be raised when a resource or an operation is locked.
+	ERROR_LOCKED = 28
+	//Will be raised when a deadlock is detected when accessing collections.
+	ERROR_DEADLOCK = 29
+	//Will be raised when a call cannot succeed because a server shutdown is already in progress.
+	ERROR_SHUTTING_DOWN = 30
+	//Will be raised when the HTTP request does not fulfill the requirements.
+	ERROR_HTTP_BAD_PARAMETER = 400
+	//Will be raised when authorization is required but the user is not authorized.
+	ERROR_HTTP_UNAUTHORIZED = 401
+	//Will be raised when the operation is forbidden.
+	ERROR_HTTP_FORBIDDEN = 403
+	//Will be raised when an URI is unknown.
+	ERROR_HTTP_NOT_FOUND = 404
+	//Will be raised when an unsupported HTTP method is used for an operation.
+	ERROR_HTTP_METHOD_NOT_ALLOWED = 405
+	//Will be raised when a precondition for an HTTP request is not met.
+	ERROR_HTTP_PRECONDITION_FAILED = 412
+	//Will be raised when an internal server is encountered.
+	ERROR_HTTP_SERVER_ERROR = 500
+	//Will be raised when a service is temporarily unavailable.
+	ERROR_HTTP_SERVICE_UNAVAILABLE = 503
+	//Will be raised when a string representation of a JSON object is corrupt.
+	ERROR_HTTP_CORRUPTED_JSON = 600
+	//Will be raised when the URL contains superfluous suffices.
+	ERROR_HTTP_SUPERFLUOUS_SUFFICES = 601
+	//Internal error that will be raised when the datafile is not in the required state.
+	ERROR_ARANGO_ILLEGAL_STATE = 1000
+	//Internal error that will be raised when the shaper encountered a problem.
+	ERROR_ARANGO_SHAPER_FAILED = 1001
+	//Internal error that will be raised when trying to write to a datafile.
+	ERROR_ARANGO_DATAFILE_SEALED = 1002
+	//Internal error that will be raised when an unknown collection type is encountered.
+	ERROR_ARANGO_UNKNOWN_COLLECTION_TYPE = 1003
+	//Internal error that will be raised when trying to write to a read-only datafile or collection.
+	ERROR_ARANGO_READ_ONLY = 1004
+	//Internal error that will be raised when a identifier duplicate is detected.
+	ERROR_ARANGO_DUPLICATE_IDENTIFIER = 1005
+	//Internal error that will be raised when a datafile is unreadable.
+	ERROR_ARANGO_DATAFILE_UNREADABLE = 1006
+	//Internal error that will be raised when a datafile is empty.
+	ERROR_ARANGO_DATAFILE_EMPTY = 1007
+	//Will be raised when an error occurred during WAL log file recovery.
+	ERROR_ARANGO_RECOVERY = 1008
+	//Will be raised when a corruption is detected in a datafile.
+	ERROR_ARANGO_CORRUPTED_DATAFILE = 1100
+	//Will be raised if a parameter file is corrupted or cannot be read.
+	ERROR_ARANGO_ILLEGAL_PARAMETER_FILE = 1101
+	//Will be raised when a collection contains one or more corrupted data files.
+	ERROR_ARANGO_CORRUPTED_COLLECTION = 1102
+	//Will be raised when the system call mmap failed.
+	ERROR_ARANGO_MMAP_FAILED = 1103
+	//Will be raised when the filesystem is full.
+	ERROR_ARANGO_FILESYSTEM_FULL = 1104
+	//Will be raised when a journal cannot be created.
+	ERROR_ARANGO_NO_JOURNAL = 1105
+	//Will be raised when the datafile cannot be created or renamed because a file of the same name already exists.
+	ERROR_ARANGO_DATAFILE_ALREADY_EXISTS = 1106
+	//Will be raised when the database directory is locked by a different process.
+	ERROR_ARANGO_DATADIR_LOCKED = 1107
+	//Will be raised when the collection cannot be created because a directory of the same name already exists.
+	ERROR_ARANGO_COLLECTION_DIRECTORY_ALREADY_EXISTS = 1108
+	//Will be raised when the system call msync failed.
+	ERROR_ARANGO_MSYNC_FAILED = 1109
+	//Will be raised when the server cannot lock the database directory on startup.
+	ERROR_ARANGO_DATADIR_UNLOCKABLE = 1110
+	//Will be raised when the server waited too long for a datafile to be synced to disk.
+	ERROR_ARANGO_SYNC_TIMEOUT = 1111
+	//Will be raised when updating or deleting a document and a conflict has been detected.
+	ERROR_ARANGO_CONFLICT = 1200
+	//Will be raised when a non-existing database directory was specified when starting the database.
+	ERROR_ARANGO_DATADIR_INVALID = 1201
+	//Will be raised when a document with a given identifier or handle is unknown.
+	ERROR_ARANGO_DOCUMENT_NOT_FOUND = 1202
+	//Will be raised when a collection with a given identifier or name is unknown.
+	ERROR_ARANGO_COLLECTION_NOT_FOUND = 1203
+	//Will be raised when the collection parameter is missing.
+	ERROR_ARANGO_COLLECTION_PARAMETER_MISSING = 1204
+	//Will be raised when a document handle is corrupt.
+	ERROR_ARANGO_DOCUMENT_HANDLE_BAD = 1205
+	//Will be raised when the maximal size of the journal is too small.
+	ERROR_ARANGO_MAXIMAL_SIZE_TOO_SMALL = 1206
+	//Will be raised when a name duplicate is detected.
+	ERROR_ARANGO_DUPLICATE_NAME = 1207
+	//Will be raised when an illegal name is detected.
+	ERROR_ARANGO_ILLEGAL_NAME = 1208
+	//Will be raised when no suitable index for the query is known.
+	ERROR_ARANGO_NO_INDEX = 1209
+	//Will be raised when there is a unique constraint violation.
+	ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED = 1210
+	//Will be raised when an index with a given identifier is unknown.
+	ERROR_ARANGO_INDEX_NOT_FOUND = 1212
+	//Will be raised when a cross-collection is requested.
+	ERROR_ARANGO_CROSS_COLLECTION_REQUEST = 1213
+	//Will be raised when a index handle is corrupt.
+	ERROR_ARANGO_INDEX_HANDLE_BAD = 1214
+	//Will be raised when a cap constraint was already defined.
+	ERROR_ARANGO_CAP_CONSTRAINT_ALREADY_DEFINED = 1215
+	//Will be raised when the document cannot fit into any datafile because of it is too large.
+	ERROR_ARANGO_DOCUMENT_TOO_LARGE = 1216
+	//Will be raised when a collection should be unloaded, but has a different status.
+	ERROR_ARANGO_COLLECTION_NOT_UNLOADED = 1217
+	//Will be raised when an invalid collection type is used in a request.
+	ERROR_ARANGO_COLLECTION_TYPE_INVALID = 1218
+	//Will be raised when the validation of an attribute of a structure failed.
+	ERROR_ARANGO_VALIDATION_FAILED = 1219
+	//Will be raised when the parsing of an attribute of a structure failed.
+	ERROR_ARANGO_PARSER_FAILED = 1220
+	//Will be raised when a document key is corrupt.
+	ERROR_ARANGO_DOCUMENT_KEY_BAD = 1221
+	//Will be raised when a user-defined document key is supplied for collections with auto key generation.
+	ERROR_ARANGO_DOCUMENT_KEY_UNEXPECTED = 1222
+	//Will be raised when the server's database directory is not writable for the current user.
+	ERROR_ARANGO_DATADIR_NOT_WRITABLE = 1224
+	//Will be raised when a key generator runs out of keys.
+	ERROR_ARANGO_OUT_OF_KEYS = 1225
+	//Will be raised when a document key is missing.
+	ERROR_ARANGO_DOCUMENT_KEY_MISSING = 1226
+	//Will be raised when there is an attempt to create a document with an invalid type.
+	ERROR_ARANGO_DOCUMENT_TYPE_INVALID = 1227
+	//Will be raised when a non-existing database is accessed.
+	ERROR_ARANGO_DATABASE_NOT_FOUND = 1228
+	//Will be raised when an invalid database name is used.
+	ERROR_ARANGO_DATABASE_NAME_INVALID = 1229
+	//Will be raised when an operation is requested in a database other than the system database.
+	ERROR_ARANGO_USE_SYSTEM_DATABASE = 1230
+	//Will be raised when there is an attempt to delete a non-existing endpoint.
+	ERROR_ARANGO_ENDPOINT_NOT_FOUND = 1231
+	//Will be raised when an invalid key generator description is used.
+	ERROR_ARANGO_INVALID_KEY_GENERATOR = 1232
+	//will be raised when the _from or _to values of an edge are undefined or contain an invalid value.
+	ERROR_ARANGO_INVALID_EDGE_ATTRIBUTE = 1233
+	//Will be raised when an attempt to insert a document into an index is caused by in the document not having one or more attributes which the index is built on.
+	ERROR_ARANGO_INDEX_DOCUMENT_ATTRIBUTE_MISSING = 1234
+	//Will be raised when an attempt to create an index has failed.
+	ERROR_ARANGO_INDEX_CREATION_FAILED = 1235
+	//Will be raised when the server is write-throttled and a write operation has waited too long for the server to process queued operations.
+	ERROR_ARANGO_WRITE_THROTTLE_TIMEOUT = 1236
+	//Will be raised when a collection has a different type from what has been expected.
+	ERROR_ARANGO_COLLECTION_TYPE_MISMATCH = 1237
+	//Will be raised when a collection is accessed that is not yet loaded.
+	ERROR_ARANGO_COLLECTION_NOT_LOADED = 1238
+	//Will be raised when the datafile reaches its limit.
+	ERROR_ARANGO_DATAFILE_FULL = 1300
+	//Will be raised when encountering an empty server database directory.
+	ERROR_ARANGO_EMPTY_DATADIR = 1301
+	//Will be raised when the replication applier does not receive any or an incomplete response from the master.
+	ERROR_REPLICATION_NO_RESPONSE = 1400
+	//Will be raised when the replication applier receives an invalid response from the master.
+	ERROR_REPLICATION_INVALID_RESPONSE = 1401
+	//Will be raised when the replication applier receives a server error from the master.
+	ERROR_REPLICATION_MASTER_ERROR = 1402
+	//Will be raised when the replication applier connects to a master that has an incompatible version.
+	ERROR_REPLICATION_MASTER_INCOMPATIBLE = 1403
+	//Will be raised when the replication applier connects to a different master than before.
+	ERROR_REPLICATION_MASTER_CHANGE = 1404
+	//Will be raised when the replication applier is asked to connect to itself for replication.
+	ERROR_REPLICATION_LOOP = 1405
+	//Will be raised when an unexpected marker is found in the replication log stream.
+	ERROR_REPLICATION_UNEXPECTED_MARKER = 1406
+	//Will be raised when an invalid replication applier state file is found.
+	ERROR_REPLICATION_INVALID_APPLIER_STATE = 1407
+	//Will be raised when an unexpected transaction id is found.
+	ERROR_REPLICATION_UNEXPECTED_TRANSACTION = 1408
+	//Will be raised when the configuration for the replication applier is invalid.
+	ERROR_REPLICATION_INVALID_APPLIER_CONFIGURATION = 1410
+	//Will be raised when there is an attempt to perform an operation while the replication applier is running.
+	ERROR_REPLICATION_RUNNING = 1411
+	//Special error code used to indicate the replication applier was stopped by a user.
+	ERROR_REPLICATION_APPLIER_STOPPED = 1412
+	//Will be raised when the replication applier is started without a known start tick value.
+	ERROR_REPLICATION_NO_START_TICK = 1413
+	//Will be raised when none of the agency servers can be connected to.
+	ERROR_CLUSTER_NO_AGENCY = 1450
+	//Will be raised when a DB server in a cluster receives a HTTP request without a coordinator header.
+	ERROR_CLUSTER_NO_COORDINATOR_HEADER = 1451
+	//Will be raised when a coordinator in a cluster cannot lock the Plan hierarchy in the agency.
+	ERROR_CLUSTER_COULD_NOT_LOCK_PLAN = 1452
+	//Will be raised when a coordinator in a cluster tries to create a collection and the collection ID already exists.
+	ERROR_CLUSTER_COLLECTION_ID_EXISTS = 1453
+	//Will be raised when a coordinator in a cluster runs into a timeout for some cluster wide operation.
+	ERROR_CLUSTER_TIMEOUT = 1457
+	//Will be raised when a coordinator tries to find out which DBserver is responsible for a shard and the shard does not exist.
+	ERROR_CLUSTER_SHARD_GONE = 1465
+	//Will be raised if a coordinator is asked to create a document in a sharded collection that is not sharded by _key and a _key is given.
+	ERROR_CLUSTER_MUST_NOT_SPECIFY_KEY = 1466
+	//Will be raised when there is an attempt to carry out an operation that is not supported in the context of a sharded collection.
+	ERROR_CLUSTER_UNSUPPORTED = 1470
+	//Will be raised if there is an attempt to run a coordinator-only operation on a different type of node.
+	ERROR_CLUSTER_ONLY_ON_COORDINATOR = 1471
+	//Will be raised if a coordinator or DBserver cannot read the Plan in the agency.
+	ERROR_CLUSTER_READING_PLAN_AGENCY = 1472
+	//Will be raised when a running query is killed by an explicit admin command.
+	ERROR_QUERY_KILLED = 1500
+	//Will be raised when query is parsed and is found to be syntactically invalid.
+	ERROR_QUERY_PARSE = 1501
+	//Will be raised when an empty query is specified.
+	ERROR_QUERY_EMPTY = 1502
+	//Will be raised when a runtime error is caused by the query.
+	ERROR_QUERY_SCRIPT = 1503
+	//Will be raised when a number is outside the expected range.
+	ERROR_QUERY_NUMBER_OUT_OF_RANGE = 1504
+	//Will be raised when an invalid variable name is used.
+	ERROR_QUERY_VARIABLE_NAME_INVALID = 1510
+	//Will be raised when a variable gets re-assigned in a query.
+	ERROR_QUERY_VARIABLE_REDECLARED = 1511
+	//Will be raised when an unknown variable is used or the variable is undefined the context it is used.
+	ERROR_QUERY_VARIABLE_NAME_UNKNOWN = 1512
+	//Will be raised when a read lock on the collection cannot be acquired.
+	ERROR_QUERY_COLLECTION_LOCK_FAILED = 1521
+	//Will be raised when the number of collections in a query is beyond the allowed value.
+	ERROR_QUERY_TOO_MANY_COLLECTIONS = 1522
+	//Will be raised when a document attribute is re-assigned.
+	ERROR_QUERY_DOCUMENT_ATTRIBUTE_REDECLARED = 1530
+	//Will be raised when an undefined function is called.
+	ERROR_QUERY_FUNCTION_NAME_UNKNOWN = 1540
+	//Will be raised when the number of arguments used in a function call does not match the expected number of arguments for the function.
+	ERROR_QUERY_FUNCTION_ARGUMENT_NUMBER_MISMATCH = 1541
+	//Will be raised when the type of an argument used in a function call does not match the expected argument type.
+	ERROR_QUERY_FUNCTION_ARGUMENT_TYPE_MISMATCH = 1542
+	//Will be raised when an invalid regex argument value is used in a call to a function that expects a regex.
+	ERROR_QUERY_INVALID_REGEX = 1543
+	//Will be raised when the structure of bind parameters passed has an unexpected format.
+	ERROR_QUERY_BIND_PARAMETERS_INVALID = 1550
+	//Will be raised when a bind parameter was declared in the query but the query is being executed with no value for that parameter.
+	ERROR_QUERY_BIND_PARAMETER_MISSING = 1551
+	//Will be raised when a value gets specified for an undeclared bind parameter.
+	ERROR_QUERY_BIND_PARAMETER_UNDECLARED = 1552
+	//Will be raised when a bind parameter has an invalid value or type.
+	ERROR_QUERY_BIND_PARAMETER_TYPE = 1553
+	//Will be raised when a non-boolean value is used in a logical operation.
+	ERROR_QUERY_INVALID_LOGICAL_VALUE = 1560
+	//Will be raised when a non-numeric value is used in an arithmetic operation.
+	ERROR_QUERY_INVALID_ARITHMETIC_VALUE = 1561
+	//Will be raised when there is an attempt to divide by zero.
+	ERROR_QUERY_DIVISION_BY_ZERO = 1562
+	//Will be raised when a non-list operand is used for an operation that expects a list argument operand.
+	ERROR_QUERY_LIST_EXPECTED = 1563
+	//Will be raised when the function FAIL() is called from inside a query.
+	ERROR_QUERY_FAIL_CALLED = 1569
+	//Will be raised when a geo restriction was specified but no suitable geo index is found to resolve it.
+	ERROR_QUERY_GEO_INDEX_MISSING = 1570
+	//Will be raised when a fulltext query is performed on a collection without a suitable fulltext index.
+	ERROR_QUERY_FULLTEXT_INDEX_MISSING = 1571
+	//Will be raised when a value cannot be converted to a date.
+	ERROR_QUERY_INVALID_DATE_VALUE = 1572
+	//Will be raised when an AQL query contains more than one data-modifying operation.
+	ERROR_QUERY_MULTI_MODIFY = 1573
+	//Will be raised when an AQL data-modification query contains options that cannot be figured out at query compile time.
+	ERROR_QUERY_COMPILE_TIME_OPTIONS = 1575
+	//Will be raised when an AQL data-modification query contains an invalid options specification.
+	ERROR_QUERY_EXCEPTION_OPTIONS = 1576
+	//Will be raised when a collection is used as an operand in an AQL expression.
+	ERROR_QUERY_COLLECTION_USED_IN_EXPRESSION = 1577
+	//Will be raised when a dynamic function call is made to a function that cannot be called dynamically.
+	ERROR_QUERY_DISALLOWED_DYNAMIC_CALL = 1578
+	//Will be raised when collection data are accessed after a data-modification operation.
+	ERROR_QUERY_ACCESS_AFTER_MODIFICATION = 1579
+	//Will be raised when a user function with an invalid name is registered.
+	ERROR_QUERY_FUNCTION_INVALID_NAME = 1580
+	//Will be raised when a user function is registered with invalid code.
+	ERROR_QUERY_FUNCTION_INVALID_CODE = 1581
+	//Will be raised when a user function is accessed but not found.
+	ERROR_QUERY_FUNCTION_NOT_FOUND = 1582
+	//Will be raised when a user function throws a runtime exception.
+	ERROR_QUERY_FUNCTION_RUNTIME_ERROR = 1583
+	//Will be raised when an HTTP API for a query got an invalid JSON object.
+	ERROR_QUERY_BAD_JSON_PLAN = 1590
+	//Will be raised when an Id of a query is not found by the HTTP API.
+	ERROR_QUERY_NOT_FOUND = 1591
+	//Will be raised when an Id of a query is found by the HTTP API but the query is in use.
+	ERROR_QUERY_IN_USE = 1592
+	//Will be raised when a cursor is requested via its id but a cursor with that id cannot be found.
+	ERROR_CURSOR_NOT_FOUND = 1600
+	//Will be raised when a cursor is requested via its id but a concurrent request is still using the cursor.
+	ERROR_CURSOR_BUSY = 1601
+	//Will be raised when a wrong usage of transactions is detected. this is an internal error and indicates a bug in ArangoDB.
+	ERROR_TRANSACTION_INTERNAL = 1650
+	//Will be raised when transactions are nested.
+	ERROR_TRANSACTION_NESTED = 1651
+	//Will be raised when a collection is used in the middle of a transaction but was not registered at transaction start.
+	ERROR_TRANSACTION_UNREGISTERED_COLLECTION = 1652
+	//Will be raised when a disallowed operation is carried out in a transaction.
+	ERROR_TRANSACTION_DISALLOWED_OPERATION = 1653
+	//Will be raised when a transaction was aborted.
+	ERROR_TRANSACTION_ABORTED = 1654
+	//Will be raised when an invalid user name is used.
+	ERROR_USER_INVALID_NAME = 1700
+	//Will be raised when an invalid password is used.
+	ERROR_USER_INVALID_PASSWORD = 1701
+	//Will be raised when a user name already exists.
+	ERROR_USER_DUPLICATE = 1702
+	//Will be raised when a user name is updated that does not exist.
+	ERROR_USER_NOT_FOUND = 1703
+	//Will be raised when the user must change his password.
+	ERROR_USER_CHANGE_PASSWORD = 1704
+	//Will be raised when an invalid name is passed to the server.
+	ERROR_GRAPH_INVALID_GRAPH = 1901
+	//Will be raised when an invalid name, vertices or edges is passed to the server.
+	ERROR_GRAPH_COULD_NOT_CREATE_GRAPH = 1902
+	//Will be raised when an invalid vertex id is passed to the server.
+	ERROR_GRAPH_INVALID_VERTEX = 1903
+	//Will be raised when the vertex could not be created.
+	ERROR_GRAPH_COULD_NOT_CREATE_VERTEX = 1904
+	//Will be raised when the vertex could not be changed.
+	ERROR_GRAPH_COULD_NOT_CHANGE_VERTEX = 1905
+	//Will be raised when an invalid edge id is passed to the server.
+	ERROR_GRAPH_INVALID_EDGE = 1906
+	//Will be raised when the edge could not be created.
+	ERROR_GRAPH_COULD_NOT_CREATE_EDGE = 1907
+	//Will be raised when the edge could not be changed.
+	ERROR_GRAPH_COULD_NOT_CHANGE_EDGE = 1908
+	//Will be raised when too many iterations are done in a graph traversal.
+	ERROR_GRAPH_TOO_MANY_ITERATIONS = 1909
+	//Will be raised when an invalid filter result is returned in a graph traversal.
+	ERROR_GRAPH_INVALID_FILTER_RESULT = 1910
+	//an edge collection may only be used once in one edge definition of a graph.
+	ERROR_GRAPH_COLLECTION_MULTI_USE = 1920
+	//is already used by another graph in a different edge definition.
+	ERROR_GRAPH_COLLECTION_USE_IN_MULTI_GRAPHS = 1921
+	//a graph name is required to create a graph.
+	ERROR_GRAPH_CREATE_MISSING_NAME = 1922
+	//the edge definition is malformed. It has to be an array of objects.
+	ERROR_GRAPH_CREATE_MALFORMED_EDGE_DEFINITION = 1923
+	//a graph with this name could not be found.
+	ERROR_GRAPH_NOT_FOUND = 1924
+	//a graph with this name already exists.
+	ERROR_GRAPH_DUPLICATE = 1925
+	//the specified vertex collection does not exist or is not part of the graph.
+	ERROR_GRAPH_VERTEX_COL_DOES_NOT_EXIST = 1926
+	//the collection is not a vertex collection.
+	ERROR_GRAPH_WRONG_COLLECTION_TYPE_VERTEX = 1927
+	//Vertex collection not in orphan collection of the graph.
+	ERROR_GRAPH_NOT_IN_ORPHAN_COLLECTION = 1928
+	//The collection is already used in an edge definition of the graph.
+	ERROR_GRAPH_COLLECTION_USED_IN_EDGE_DEF = 1929
+	//The edge collection is not used in any edge definition of the graph.
+	ERROR_GRAPH_EDGE_COLLECTION_NOT_USED = 1930
+	//The collection is not an ArangoCollection.
+	ERROR_GRAPH_NOT_AN_ARANGO_COLLECTION = 1931
+	//collection _graphs does not exist.
+	ERROR_GRAPH_NO_GRAPH_COLLECTION = 1932
+	//Invalid example type. Has to be String, Array or Object.
+	ERROR_GRAPH_INVALID_EXAMPLE_ARRAY_OBJECT_STRING = 1933
+	//Invalid example type. Has to be Array or Object.
+	ERROR_GRAPH_INVALID_EXAMPLE_ARRAY_OBJECT = 1934
+	//Invalid number of arguments. Expected:
+	ERROR_GRAPH_INVALID_NUMBER_OF_ARGUMENTS = 1935
+	//Invalid parameter type.
+	ERROR_GRAPH_INVALID_PARAMETER = 1936
+	//Invalid id
+	ERROR_GRAPH_INVALID_ID = 1937
+	//The collection is already used in the orphans of the graph.
+	ERROR_GRAPH_COLLECTION_USED_IN_ORPHANS = 1938
+	//the specified edge collection does not exist or is not part of the graph.
+	ERROR_GRAPH_EDGE_COL_DOES_NOT_EXIST = 1939
+	//The requested graph has no edge collections.
+	ERROR_GRAPH_EMPTY = 1940
+	//Will be raised when an invalid/unknown session id is passed to the server.
+	ERROR_SESSION_UNKNOWN = 1950
+	//Will be raised when a session is expired.
+	ERROR_SESSION_EXPIRED = 1951
+	//This error should not happen.
+	SIMPLE_CLIENT_UNKNOWN_ERROR = 2000
+	//Will be raised when the client could not connect to the server.
+	SIMPLE_CLIENT_COULD_NOT_CONNECT = 2001
+	//Will be raised when the client could not write data.
+	SIMPLE_CLIENT_COULD_NOT_WRITE = 2002
+	//Will be raised when the client could not read data.
+	SIMPLE_CLIENT_COULD_NOT_READ = 2003
+)
+
+var errorNames = map[int]string{
+	0:    "ERROR_NO_ERROR",
+	1:    "ERROR_FAILED",
+	2:    "ERROR_SYS_ERROR",
+	3:    "ERROR_OUT_OF_MEMORY",
+	4:    "ERROR_INTERNAL",
+	5:    "ERROR_ILLEGAL_NUMBER",
+	6:    "ERROR_NUMERIC_OVERFLOW",
+	7:    "ERROR_ILLEGAL_OPTION",
+	8:    "ERROR_DEAD_PID",
+	9:    "ERROR_NOT_IMPLEMENTED",
+	10:   "ERROR_BAD_PARAMETER",
+	11:   "ERROR_FORBIDDEN",
+	12:   "ERROR_OUT_OF_MEMORY_MMAP",
+	13:   "ERROR_CORRUPTED_CSV",
+	14:   "ERROR_FILE_NOT_FOUND",
+	15:   "ERROR_CANNOT_WRITE_FILE",
+	16:   "ERROR_CANNOT_OVERWRITE_FILE",
+	17:   "ERROR_TYPE_ERROR",
+	18:   "ERROR_LOCK_TIMEOUT",
+	19:   "ERROR_CANNOT_CREATE_DIRECTORY",
+	20:   "ERROR_CANNOT_CREATE_TEMP_FILE",
+	21:   "ERROR_REQUEST_CANCELED",
+	22:   "ERROR_DEBUG",
+	25:   "ERROR_IP_ADDRESS_INVALID",
+	27:   "ERROR_FILE_EXISTS",
+	28:   "ERROR_LOCKED",
+	29:   "ERROR_DEADLOCK",
+	30:   "ERROR_SHUTTING_DOWN",
+	400:  "ERROR_HTTP_BAD_PARAMETER",
+	401:  "ERROR_HTTP_UNAUTHORIZED",
+	403:  "ERROR_HTTP_FORBIDDEN",
+	404:  "ERROR_HTTP_NOT_FOUND",
+	405:  "ERROR_HTTP_METHOD_NOT_ALLOWED",
+	412:  "ERROR_HTTP_PRECONDITION_FAILED",
+	500:  "ERROR_HTTP_SERVER_ERROR",
+	503:  "ERROR_HTTP_SERVICE_UNAVAILABLE",
+	600:  "ERROR_HTTP_CORRUPTED_JSON",
+	601:  "ERROR_HTTP_SUPERFLUOUS_SUFFICES",
+	1000: "ERROR_ARANGO_ILLEGAL_STATE",
+	1001: "ERROR_ARANGO_SHAPER_FAILED",
+	1002: "ERROR_ARANGO_DATAFILE_SEALED",
+	1003: "ERROR_ARANGO_UNKNOWN_COLLECTION_TYPE",
+	1004: "ERROR_ARANGO_READ_ONLY",
+	1005: "ERROR_ARANGO_DUPLICATE_IDENTIFIER",
+	1006: "ERROR_ARANGO_DATAFILE_UNREADABLE",
+	1007: "ERROR_ARANGO_DATAFILE_EMPTY",
+	1008: "ERROR_ARANGO_RECOVERY",
+	1100: "ERROR_ARANGO_CORRUPTED_DATAFILE",
+	1101: "ERROR_ARANGO_ILLEGAL_PARAMETER_FILE",
+	1102: "ERROR_ARANGO_CORRUPTED_COLLECTION",
+	1103: "ERROR_ARANGO_MMAP_FAILED",
+	1104: "ERROR_ARANGO_FILESYSTEM_FULL",
+	1105: "ERROR_ARANGO_NO_JOURNAL",
+	1106: "ERROR_ARANGO_DATAFILE_ALREADY_EXISTS",
+	1107: "ERROR_ARANGO_DATADIR_LOCKED",
+	1108: "ERROR_ARANGO_COLLECTION_DIRECTORY_ALREADY_EXISTS",
+	1109: "ERROR_ARANGO_MSYNC_FAILED",
+	1110: "ERROR_ARANGO_DATADIR_UNLOCKABLE",
+	1111: "ERROR_ARANGO_SYNC_TIMEOUT",
+	1200: "ERROR_ARANGO_CONFLICT",
+	1201: "ERROR_ARANGO_DATADIR_INVALID",
+	1202: "ERROR_ARANGO_DOCUMENT_NOT_FOUND",
+	1203: "ERROR_ARANGO_COLLECTION_NOT_FOUND",
+	1204: "ERROR_ARANGO_COLLECTION_PARAMETER_MISSING",
+	1205: "ERROR_ARANGO_DOCUMENT_HANDLE_BAD",
+	1206: "ERROR_ARANGO_MAXIMAL_SIZE_TOO_SMALL",
+	1207: "ERROR_ARANGO_DUPLICATE_NAME",
+	1208: "ERROR_ARANGO_ILLEGAL_NAME",
+	1209: "ERROR_ARANGO_NO_INDEX",
+	1210: "ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED",
+	1212: "ERROR_ARANGO_INDEX_NOT_FOUND",
+	1213: "ERROR_ARANGO_CROSS_COLLECTION_REQUEST",
+	1214: "ERROR_ARANGO_INDEX_HANDLE_BAD",
+	1215: "ERROR_ARANGO_CAP_CONSTRAINT_ALREADY_DEFINED",
+	1216: "ERROR_ARANGO_DOCUMENT_TOO_LARGE",
+	1217: "ERROR_ARANGO_COLLECTION_NOT_UNLOADED",
+	1218: "ERROR_ARANGO_COLLECTION_TYPE_INVALID",
+	1219: "ERROR_ARANGO_VALIDATION_FAILED",
+	1220: "ERROR_ARANGO_PARSER_FAILED",
+	1221: "ERROR_ARANGO_DOCUMENT_KEY_BAD",
+	1222: "ERROR_ARANGO_DOCUMENT_KEY_UNEXPECTED",
+	1224: "ERROR_ARANGO_DATADIR_NOT_WRITABLE",
+	1225: "ERROR_ARANGO_OUT_OF_KEYS",
+	1226: "ERROR_ARANGO_DOCUMENT_KEY_MISSING",
+	1227: "ERROR_ARANGO_DOCUMENT_TYPE_INVALID",
+	1228: "ERROR_ARANGO_DATABASE_NOT_FOUND",
+	1229: "ERROR_ARANGO_DATABASE_NAME_INVALID",
+	1230: "ERROR_ARANGO_USE_SYSTEM_DATABASE",
+	1231: "ERROR_ARANGO_ENDPOINT_NOT_FOUND",
+	1232: "ERROR_ARANGO_INVALID_KEY_GENERATOR",
+	1233: "ERROR_ARANGO_INVALID_EDGE_ATTRIBUTE",
+	1234: "ERROR_ARANGO_INDEX_DOCUMENT_ATTRIBUTE_MISSING",
+	1235: "ERROR_ARANGO_INDEX_CREATION_FAILED",
+	1236: "ERROR_ARANGO_WRITE_THROTTLE_TIMEOUT",
+	1237: "ERROR_ARANGO_COLLECTION_TYPE_MISMATCH",
+	1238: "ERROR_ARANGO_COLLECTION_NOT_LOADED",
+	1300: "ERROR_ARANGO_DATAFILE_FULL",
+	1301: "ERROR_ARANGO_EMPTY_DATADIR",
+	1400: "ERROR_REPLICATION_NO_RESPONSE",
+	1401: "ERROR_REPLICATION_INVALID_RESPONSE",
+	1402: "ERROR_REPLICATION_MASTER_ERROR",
+	1403: "ERROR_REPLICATION_MASTER_INCOMPATIBLE",
+	1404: "ERROR_REPLICATION_MASTER_CHANGE",
+	1405: "ERROR_REPLICATION_LOOP",
+	1406: "ERROR_REPLICATION_UNEXPECTED_MARKER",
+	1407: "ERROR_REPLICATION_INVALID_APPLIER_STATE",
+	1408: "ERROR_REPLICATION_UNEXPECTED_TRANSACTION",
+	1410: "ERROR_REPLICATION_INVALID_APPLIER_CONFIGURATION",
+	1411: "ERROR_REPLICATION_RUNNING",
+	1412: "ERROR_REPLICATION_APPLIER_STOPPED",
+	1413: "ERROR_REPLICATION_NO_START_TICK",
+	1450: "ERROR_CLUSTER_NO_AGENCY",
+	1451: "ERROR_CLUSTER_NO_COORDINATOR_HEADER",
+	1452: "ERROR_CLUSTER_COULD_NOT_LOCK_PLAN",
+	1453: "ERROR_CLUSTER_COLLECTION_ID_EXISTS",
+	1457: "ERROR_CLUSTER_TIMEOUT",
+	1465: "ERROR_CLUSTER_SHARD_GONE",
+	1466: "ERROR_CLUSTER_MUST_NOT_SPECIFY_KEY",
+	1470: "ERROR_CLUSTER_UNSUPPORTED",
+	1471: "ERROR_CLUSTER_ONLY_ON_COORDINATOR",
+	1472: "ERROR_CLUSTER_READING_PLAN_AGENCY",
+	1500: "ERROR_QUERY_KILLED",
+	1501: "ERROR_QUERY_PARSE",
+	1502: "ERROR_QUERY_EMPTY",
+	1503: "ERROR_QUERY_SCRIPT",
+	1504: "ERROR_QUERY_NUMBER_OUT_OF_RANGE",
+	1510: "ERROR_QUERY_VARIABLE_NAME_INVALID",
+	1511: "ERROR_QUERY_VARIABLE_REDECLARED",
+	1512: "ERROR_QUERY_VARIABLE_NAME_UNKNOWN",
+	1521: "ERROR_QUERY_COLLECTION_LOCK_FAILED",
+	1522: "ERROR_QUERY_TOO_MANY_COLLECTIONS",
+	1530: "ERROR_QUERY_DOCUMENT_ATTRIBUTE_REDECLARED",
+	1540: "ERROR_QUERY_FUNCTION_NAME_UNKNOWN",
+	1541: "ERROR_QUERY_FUNCTION_ARGUMENT_NUMBER_MISMATCH",
+	1542: "ERROR_QUERY_FUNCTION_ARGUMENT_TYPE_MISMATCH",
+	1543: "ERROR_QUERY_INVALID_REGEX",
+	1550: "ERROR_QUERY_BIND_PARAMETERS_INVALID",
+	1551: "ERROR_QUERY_BIND_PARAMETER_MISSING",
+	1552: "ERROR_QUERY_BIND_PARAMETER_UNDECLARED",
+	1553: "ERROR_QUERY_BIND_PARAMETER_TYPE",
+	1560: "ERROR_QUERY_INVALID_LOGICAL_VALUE",
+	1561: "ERROR_QUERY_INVALID_ARITHMETIC_VALUE",
+	1562: "ERROR_QUERY_DIVISION_BY_ZERO",
+	1563: "ERROR_QUERY_LIST_EXPECTED",
+	1569: "ERROR_QUERY_FAIL_CALLED",
+	1570: "ERROR_QUERY_GEO_INDEX_MISSING",
+	1571: "ERROR_QUERY_FULLTEXT_INDEX_MISSING",
+	1572: "ERROR_QUERY_INVALID_DATE_VALUE",
+	1573: "ERROR_QUERY_MULTI_MODIFY",
+	1575: "ERROR_QUERY_COMPILE_TIME_OPTIONS",
+	1576: "ERROR_QUERY_EXCEPTION_OPTIONS",
+	1577: "ERROR_QUERY_COLLECTION_USED_IN_EXPRESSION",
+	1578: "ERROR_QUERY_DISALLOWED_DYNAMIC_CALL",
+	1579: "ERROR_QUERY_ACCESS_AFTER_MODIFICATION",
+	1580: "ERROR_QUERY_FUNCTION_INVALID_NAME",
+	1581: "ERROR_QUERY_FUNCTION_INVALID_CODE",
+	1582: "ERROR_QUERY_FUNCTION_NOT_FOUND",
+	1583: "ERROR_QUERY_FUNCTION_RUNTIME_ERROR",
+	1590: "ERROR_QUERY_BAD_JSON_PLAN",
+	1591: "ERROR_QUERY_NOT_FOUND",
+	1592: "ERROR_QUERY_IN_USE",
+	1600: "ERROR_CURSOR_NOT_FOUND",
+	1601: "ERROR_CURSOR_BUSY",
+	1650: "ERROR_TRANSACTION_INTERNAL",
+	1651: "ERROR_TRANSACTION_NESTED",
+	1652: "ERROR_TRANSACTION_UNREGISTERED_COLLECTION",
+	1653: "ERROR_TRANSACTION_DISALLOWED_OPERATION",
+	1654: "ERROR_TRANSACTION_ABORTED",
+	1700: "ERROR_USER_INVALID_NAME",
+	1701: "ERROR_USER_INVALID_PASSWORD",
+	1702: "ERROR_USER_DUPLICATE",
+	1703: "ERROR_USER_NOT_FOUND",
+	1704: "ERROR_USER_CHANGE_PASSWORD",
+	1901: "ERROR_GRAPH_INVALID_GRAPH",
+	1902: "ERROR_GRAPH_COULD_NOT_CREATE_GRAPH",
+	1903: "ERROR_GRAPH_INVALID_VERTEX",
+	1904: "ERROR_GRAPH_COULD_NOT_CREATE_VERTEX",
+	1905: "ERROR_GRAPH_COULD_NOT_CHANGE_VERTEX",
+	1906: "ERROR_GRAPH_INVALID_EDGE",
+	1907: "ERROR_GRAPH_COULD_NOT_CREATE_EDGE",
+	1908: "ERROR_GRAPH_COULD_NOT_CHANGE_EDGE",
+	1909: "ERROR_GRAPH_TOO_MANY_ITERATIONS",
+	1910: "ERROR_GRAPH_INVALID_FILTER_RESULT",
+	1920: "ERROR_GRAPH_COLLECTION_MULTI_USE",
+	1921: "ERROR_GRAPH_COLLECTION_USE_IN_MULTI_GRAPHS",
+	1922: "ERROR_GRAPH_CREATE_MISSING_NAME",
+	1923: "ERROR_GRAPH_CREATE_MALFORMED_EDGE_DEFINITION",
+	1924: "ERROR_GRAPH_NOT_FOUND",
+	1925: "ERROR_GRAPH_DUPLICATE",
+	1926: "ERROR_GRAPH_VERTEX_COL_DOES_NOT_EXIST",
+	1927: "ERROR_GRAPH_WRONG_COLLECTION_TYPE_VERTEX",
+	1928: "ERROR_GRAPH_NOT_IN_ORPHAN_COLLECTION",
+	1929: "ERROR_GRAPH_COLLECTION_USED_IN_EDGE_DEF",
+	1930: "ERROR_GRAPH_EDGE_COLLECTION_NOT_USED",
+	1931: "ERROR_GRAPH_NOT_AN_ARANGO_COLLECTION",
+	1932: "ERROR_GRAPH_NO_GRAPH_COLLECTION",
+	1933: "ERROR_GRAPH_INVALID_EXAMPLE_ARRAY_OBJECT_STRING",
+	1934: "ERROR_GRAPH_INVALID_EXAMPLE_ARRAY_OBJECT",
+	1935: "ERROR_GRAPH_INVALID_NUMBER_OF_ARGUMENTS",
+	1936: "ERROR_GRAPH_INVALID_PARAMETER",
+	1937: "ERROR_GRAPH_INVALID_ID",
+	1938: "ERROR_GRAPH_COLLECTION_USED_IN_ORPHANS",
+	1939: "ERROR_GRAPH_EDGE_COL_DOES_NOT_EXIST",
+	1940: "ERROR_GRAPH_EMPTY",
+	1950: "ERROR_SESSION_UNKNOWN",
+	1951: "ERROR_SESSION_EXPIRED",
+	2000: "SIMPLE_CLIENT_UNKNOWN_ERROR",
+	2001: "SIMPLE_CLIENT_COULD_NOT_CONNECT",
+	2002: "SIMPLE_CLIENT_COULD_NOT_WRITE",
+	2003: "SIMPLE_CLIENT_COULD_NOT_READ",
+}
+
+var errorMessages = map[int]string{
+	0:    "no error",
+	1:    "failed",
+	2:    "system error",
+	3:    "out of memory",
+	4:    "internal error",
+	5:    "illegal number",
+	6:    "numeric overflow",
+	7:    "illegal option",
+	8:    "dead process identifier",
+	9:    "not implemented",
+	10:   "bad parameter",
+	11:   "forbidden",
+	12:   "out of memory in mmap",
+	13:   "csv is corrupt",
+	14:   "file not found",
+	15:   "cannot write file",
+	16:   "cannot overwrite file",
+	17:   "type error",
+	18:   "lock timeout",
+	19:   "cannot create directory",
+	20:   "cannot create temporary file",
+	21:   "canceled request",
+	22:   "intentional debug error",
+	25:   "IP address is invalid",
+	27:   "file exists",
+	28:   "locked",
+	29:   "deadlock detected",
+	30:   "shutdown in progress",
+	400:  "bad parameter",
+	401:  "unauthorized",
+	403:  "forbidden",
+	404:  "not found",
+	405:  "method not supported",
+	412:  "precondition failed",
+	500:  "internal server error",
+	503:  "service unavailable",
+	600:  "invalid JSON object",
+	601:  "superfluous URL suffices",
+	1000: "illegal state",
+	1001: "could not shape document",
+	1002: "datafile sealed",
+	1003: "unknown type",
+	1004: "read only",
+	1005: "duplicate identifier",
+	1006: "datafile unreadable",
+	1007: "datafile empty",
+	1008: "logfile recovery error",
+	1100: "corrupted datafile",
+	1101: "illegal or unreadable parameter file",
+	1102: "corrupted collection",
+	1103: "mmap failed",
+	1104: "filesystem full",
+	1105: "no journal",
+	1106: "cannot create/rename datafile because it already exists",
+	1107: "database directory is locked",
+	1108: "cannot create/rename collection because directory already exists",
+	1109: "msync failed",
+	1110: "cannot lock database directory",
+	1111: "sync timeout",
+	1200: "conflict",
+	1201: "invalid database directory",
+	1202: "document not found",
+	1203: "collection not found",
+	1204: "parameter 'collection' not found",
+	1205: "illegal document handle",
+	1206: "maximal size of journal too small",
+	1207: "duplicate name",
+	1208: "illegal name",
+	1209: "no suitable index known",
+	1210: "unique constraint violated",
+	1212: "index not found",
+	1213: "cross collection request not allowed",
+	1214: "illegal index handle",
+	1215: "cap constraint already defined",
+	1216: "document too large",
+	1217: "collection must be unloaded",
+	1218: "collection type invalid",
+	1219: "validator failed",
+	1220: "parser failed",
+	1221: "illegal document key",
+	1222: "unexpected document key",
+	1224: "server database directory not writable",
+	1225: "out of keys",
+	1226: "missing document key",
+	1227: "invalid document type",
+	1228: "database not found",
+	1229: "database name invalid",
+	1230: "operation only allowed in system database",
+	1231: "endpoint not found",
+	1232: "invalid key generator",
+	1233: "edge attribute missing",
+	1234: "index insertion warning - attribute missing in document",
+	1235: "index creation failed",
+	1236: "write-throttling timeout",
+	1237: "collection type mismatch",
+	1238: "collection not loaded",
+	1300: "datafile full",
+	1301: "server database directory is empty",
+	1400: "no response",
+	1401: "invalid response",
+	1402: "master error",
+	1403: "master incompatible",
+	1404: "master change",
+	1405: "loop detected",
+	1406: "unexpected marker",
+	1407: "invalid applier state",
+	1408: "invalid transaction",
+	1410: "invalid replication applier configuration",
+	1411: "cannot perform operation while applier is running",
+	1412: "replication stopped",
+	1413: "no start tick",
+	1450: "could not connect to agency",
+	1451: "missing coordinator header",
+	1452: "could not lock plan in agency",
+	1453: "collection ID already exists",
+	1457: "timeout in cluster operation",
+	1465: "shard is gone",
+	1466: "must not specify _key for this collection",
+	1470: "unsupported operation or parameter",
+	1471: "this operation is only valid on a coordinator in a cluster",
+	1472: "error reading Plan in agency",
+	1500: "query killed",
+	1501: "%s",
+	1502: "query is empty",
+	1503: "runtime error '%s'",
+	1504: "number out of range",
+	1510: "variable name '%s' has an invalid format",
+	1511: "variable '%s' is assigned multiple times",
+	1512: "unknown variable '%s'",
+	1521: "unable to read-lock collection %s",
+	1522: "too many collections",
+	1530: "document attribute '%s' is assigned multiple times",
+	1540: "usage of unknown function '%s()'",
+	1541: "invalid number of arguments for function '%s()', expected number of arguments: minimum: %d, maximum: %d",
+	1542: "invalid argument type in call to function '%s()'",
+	1543: "invalid regex value",
+	1550: "invalid structure of bind parameters",
+	1551: "no value specified for declared bind parameter '%s'",
+	1552: "bind parameter '%s' was not declared in the query",
+	1553: "bind parameter '%s' has an invalid value or type",
+	1560: "invalid logical value",
+	1561: "invalid arithmetic value",
+	1562: "division by zero",
+	1563: "list expected",
+	1569: "FAIL(%s) called",
+	1570: "no suitable geo index found for geo restriction on '%s'",
+	1571: "no suitable fulltext index found for fulltext query on '%s'",
+	1572: "invalid date value",
+	1573: "multi-modify query",
+	1575: "query options must be readable at query compile time",
+	1576: "query options expected",
+	1577: "collection '%s' used as expression operand",
+	1578: "disallowed dynamic call to '%s'",
+	1579: "access after data-modification",
+	1580: "invalid user function name",
+	1581: "invalid user function code",
+	1582: "user function '%s()' not found",
+	1583: "user function runtime error: %s",
+	1590: "bad execution plan JSON",
+	1591: "query ID not found",
+	1592: "query with this ID is in use",
+	1600: "cursor not found",
+	1601: "cursor is busy",
+	1650: "internal transaction error",
+	1651: "nested transactions detected",
+	1652: "unregistered collection used in transaction",
+	1653: "disallowed operation inside transaction",
+	1654: "transaction aborted",
+	1700: "invalid user name",
+	1701: "invalid password",
+	1702: "duplicate user",
+	1703: "user not found",
+	1704: "user must change his password",
+	1901: "invalid graph",
+	1902: "could not create graph",
+	1903: "invalid vertex",
+	1904: "could not create vertex",
+	1905: "could not change vertex",
+	1906: "invalid edge",
+	1907: "could not create edge",
+	1908: "could not change edge",
+	1909: "too many iterations - try increasing the value of 'maxIterations'",
+	1910: "invalid filter result",
+	1920: "multi use of edge collection in edge def",
+	1921: "edge collection already used in edge def",
+	1922: "missing graph name",
+	1923: "malformed edge definition",
+	1924: "graph not found",
+	1925: "graph already exists",
+	1926: "vertex collection does not exist or is not part of the graph",
+	1927: "not a vertex collection",
+	1928: "not in orphan collection",
+	1929: "collection already used in edge def",
+	1930: "edge collection not used in graph",
+	1931: " is not an ArangoCollection",
+	1932: "collection _graphs does not exist",
+	1933: "Invalid example type. Has to be String, Array or Object",
+	1934: "Invalid example type. Has to be Array or Object",
+	1935: "Invalid number of arguments. Expected: ",
+	1936: "Invalid parameter type.",
+	1937: "Invalid id",
+	1938: "collection used in orphans",
+	1939: "edge collection does not exist or is not part of the graph",
+	1940: "empty graph",
+	1950: "unknown session",
+	1951: "session expired",
+	2000: "unknown client error",
+	2001: "could not connect to server",
+	2002: "could not write to server",
+	2003: "could not read from server",
+}
@@ -2,8 +2,12 @@ package arango
 
 import (
     "encoding/json"
+    "errors"
+    "fmt"
 )
 
+//go:generate go run gen_errors.go -in $ARANGODB_SOURCE/lib/Basics/errors.dat -out error_codes.go
+
 //ArangoError is the base set of json fields in a typical arango response.
 //All the api functions will return an ArangoError when something
 //bad happens. Nil will be returned when things went as planned.
@@ -13,6 +17,11 @@ import (
 //Code and ErrorNum will be -1. Otherwise, if we succeeded in making
 //the request to the server, they will contain whatever the server/api
 //would normally return.
+//
+//ArangoError works with errors.Is and errors.As. Compare against
+//the Err* values below instead of checking ErrorNum by hand, and
+//use errors.Unwrap to get at the transport error, if any, that
+//made the request fail.
 type ArangoError struct {
 	IsError      bool `json:"error"`
     Code         int  `json:"code"`
@@ -26,13 +35,121 @@ type ArangoError struct {
     Id string `json:"_id,omitempty"`
     Rev string `json:"_rev,omitempty"`
     Key string `json:"_key,omitempty"`
+
+    //the error that caused this one, if any. It is kept behind
+    //a pointer so ArangoError values stay comparable.
+    cause *errorCause
+}
+
+type errorCause struct {
+    err error
 }
 
+//Sentinel errors for the errors arango returns most often.
+//Use them with errors.Is:
+//
+//  if errors.Is(err, arango.ErrDocumentNotFound) { ... }
+//
+//A sentinel matches any ArangoError with the same ErrorNum. Sentinels
+//without an ErrorNum match on the http status Code instead.
+var (
+    ErrDocumentNotFound         = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_ARANGO_DOCUMENT_NOT_FOUND, ErrorMessage: "document not found"}
+    ErrCollectionNotFound       = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_ARANGO_COLLECTION_NOT_FOUND, ErrorMessage: "collection not found"}
+    ErrDatabaseNotFound         = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_ARANGO_DATABASE_NOT_FOUND, ErrorMessage: "database not found"}
+    ErrIndexNotFound            = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_ARANGO_INDEX_NOT_FOUND, ErrorMessage: "index not found"}
+    ErrCursorNotFound           = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_CURSOR_NOT_FOUND, ErrorMessage: "cursor not found"}
+    ErrUserNotFound             = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_USER_NOT_FOUND, ErrorMessage: "user not found"}
+    ErrGraphNotFound            = ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_GRAPH_NOT_FOUND, ErrorMessage: "graph not found"}
+    ErrUniqueConstraintViolated = ArangoError{IsError: true, Code: 409, ErrorNum: ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED, ErrorMessage: "unique constraint violated"}
+    ErrConflict                 = ArangoError{IsError: true, Code: 409, ErrorNum: ERROR_ARANGO_CONFLICT, ErrorMessage: "conflict"}
+    ErrDuplicateName            = ArangoError{IsError: true, Code: 409, ErrorNum: ERROR_ARANGO_DUPLICATE_NAME, ErrorMessage: "duplicate name"}
+    ErrDuplicateUser            = ArangoError{IsError: true, Code: 409, ErrorNum: ERROR_USER_DUPLICATE, ErrorMessage: "duplicate user"}
+    ErrQueryParse               = ArangoError{IsError: true, Code: 400, ErrorNum: ERROR_QUERY_PARSE, ErrorMessage: "query parse error"}
+    ErrBadParameter             = ArangoError{IsError: true, Code: 400, ErrorMessage: "bad parameter"}
+    ErrUnauthorized             = ArangoError{IsError: true, Code: 401, ErrorMessage: "unauthorized"}
+    ErrForbidden                = ArangoError{IsError: true, Code: 403, ErrorMessage: "forbidden"}
+    ErrNotFound                 = ArangoError{IsError: true, Code: 404, ErrorMessage: "not found"}
+    ErrPreconditionFailed       = ArangoError{IsError: true, Code: 412, ErrorMessage: "precondition failed"}
+    ErrServiceUnavailable       = ArangoError{IsError: true, Code: 503, ErrorMessage: "service unavailable"}
+)
+
+//ErrNoMoreResults is returned once a cursor has been read to the
+//end. Cursor.Next wraps it in an ArangoError so use errors.Is to
+//check for it.
+var ErrNoMoreResults = errors.New("arango: no more results")
+
+//Error returns a as json. When arango didn't send an errorMessage
+//the description of the ErrorNum is used instead.
 func (a ArangoError) Error() string {
+    if a.ErrorMessage == "" && a.ErrorNum > 0 {
+        a.ErrorMessage = errorMessages[a.ErrorNum]
+    }
     b, _ := json.Marshal( a )
 	return string( b )
 }
 
+//Is reports whether a matches target. It lets errors.Is
+//compare against the Err* sentinels.
+func (a ArangoError) Is(target error) bool {
+    t, ok := target.(ArangoError)
+    if !ok {
+        return false
+    }
+    if t.ErrorNum != 0 {
+        return a.ErrorNum == t.ErrorNum
+    }
+    return t.Code != 0 && a.Code == t.Code
+}
+
+//Unwrap returns the error that caused a, like the network
+//error of a failed request. It is nil for errors returned by arango.
+func (a ArangoError) Unwrap() error {
+    if a.cause == nil {
+        return nil
+    }
+    return a.cause.err
+}
+
+//Name returns the arango name of the ErrorNum, like
+//ERROR_ARANGO_DOCUMENT_NOT_FOUND.
+func (a ArangoError) Name() string {
+    return ErrorName(a.ErrorNum)
+}
+
+//ErrorName returns the arango name of an error number, like
+//ERROR_ARANGO_DOCUMENT_NOT_FOUND for 1202. Unknown numbers
+//are returned as ERROR_<num>.
+func ErrorName(errorNum int) string {
+    if name, ok := errorNames[errorNum]; ok {
+        return name
+    }
+    return fmt.Sprintf("ERROR_%d", errorNum)
+}
+
+//IsNotFound reports whether err means a document, collection or
+//anything else that was asked for doesn't exist.
+func IsNotFound(err error) bool {
+    var a ArangoError
+    return errors.As(err, &a) && a.Code == 404
+}
+
+//IsConflict reports whether err is a revision conflict, a failed
+//precondition or a unique constraint violation.
+func IsConflict(err error) bool {
+    var a ArangoError
+    return errors.As(err, &a) &&
+        (a.Code == 409 || a.Code == 412 ||
+            a.ErrorNum == ERROR_ARANGO_CONFLICT ||
+            a.ErrorNum == ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED)
+}
+
+//IsUnauthorized reports whether err means the credentials were
+//wrong or the user isn't allowed to do what was asked.
+func IsUnauthorized(err error) bool {
+    var a ArangoError
+    return errors.As(err, &a) && (a.Code == 401 || a.Code == 403)
+}
+
 func newError( msg string ) ArangoError{
     return ArangoError{
         IsError : true,
@@ -41,3 +158,11 @@ func newError( msg string ) ArangoError{
         ErrorMessage : msg,
     }
 }
+
+//wrapError is like newError but keeps err around
+//so it can be retrieved with errors.Unwrap.
+func wrapError( err error ) ArangoError{
+    e := newError( err.Error() )
+    e.cause = &errorCause{ err : err }
+    return e
+}
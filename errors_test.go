@@ -0,0 +1,81 @@
+package arango
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestErrorsIs(t *testing.T) {
+
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprint(w, `{"error":true,"code":404,"errorNum":1202,"errorMessage":"document not found"}`)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc DocumentImplementation
+	err = db.Document("testing/1", &doc)
+
+	if !errors.Is(err, ErrDocumentNotFound) {
+		t.Fatalf("Expected a document not found error but got %v", err)
+	}
+
+	if !errors.Is(err, ErrNotFound) || !IsNotFound(err) {
+		t.Fatal("Expected a 404 to match ErrNotFound.")
+	}
+
+	if errors.Is(err, ErrCollectionNotFound) || IsConflict(err) {
+		t.Fatal("Did not expect the error to match a different error number.")
+	}
+
+	var e ArangoError
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &e) || e.Name() != "ERROR_ARANGO_DOCUMENT_NOT_FOUND" {
+		t.Fatalf("Expected to get the ArangoError back out of a wrapped error: %+v", e)
+	}
+
+	if ErrorName(ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED) != "ERROR_ARANGO_UNIQUE_CONSTRAINT_VIOLATED" {
+		t.Fatal("The error name table does not match the error constants.")
+	}
+
+	if ErrorName(-42) != "ERROR_-42" {
+		t.Fatal("Expected unknown error numbers to get a generic name.")
+	}
+
+	bare := ArangoError{IsError: true, Code: 404, ErrorNum: ERROR_ARANGO_DOCUMENT_NOT_FOUND}
+
+	if !strings.Contains(bare.Error(), `"errorMessage":"document not found"`) {
+		t.Fatalf("Expected the message of the error number to fill in for a missing one: %s", bare.Error())
+	}
+}
+
+func TestErrorsUnwrapTransportError(t *testing.T) {
+
+	_, err := Conn("http://root@localhost:1")
+
+	if err == nil {
+		t.Fatal("Expected an error when connecting to a closed port.")
+	}
+
+	if _, ok := err.(ArangoError); !ok {
+		t.Fatalf("Expected ArangoError but got something else (%T, %v)", err, err)
+	}
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("Expected the network error to be kept as the cause: %v", err)
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		t.Fatal("A transport error should not match any server error.")
+	}
+}
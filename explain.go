@@ -0,0 +1,260 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+//Execution plan node types that are interesting when checking
+//how a query is going to run.
+const (
+	NODE_SINGLETON            = "SingletonNode"
+	NODE_ENUMERATE_COLLECTION = "EnumerateCollectionNode"
+	NODE_INDEX                = "IndexNode"
+	NODE_ENUMERATE_LIST       = "EnumerateListNode"
+	NODE_FILTER               = "FilterNode"
+	NODE_LIMIT                = "LimitNode"
+	NODE_CALCULATION          = "CalculationNode"
+	NODE_SORT                 = "SortNode"
+	NODE_COLLECT              = "CollectNode"
+	NODE_RETURN               = "ReturnNode"
+	NODE_TRAVERSAL            = "TraversalNode"
+	NODE_SHORTEST_PATH        = "ShortestPathNode"
+	NODE_INSERT               = "InsertNode"
+	NODE_REMOVE               = "RemoveNode"
+	NODE_REPLACE              = "ReplaceNode"
+	NODE_UPDATE               = "UpdateNode"
+	NODE_UPSERT               = "UpsertNode"
+	NODE_NO_RESULTS           = "NoResultsNode"
+)
+
+//ExplainOptions are the options of ExplainQuery.
+type ExplainOptions struct {
+	//AllPlans returns every plan the optimizer came up with
+	//instead of only the best one.
+	AllPlans bool `json:"allPlans,omitempty"`
+
+	//MaxNumberOfPlans limits the number of plans the optimizer
+	//creates. If it is 0 then it is ignored.
+	MaxNumberOfPlans int `json:"maxNumberOfPlans,omitempty"`
+}
+
+//ExplainResult is what ExplainQuery returns. Plan is set unless
+//AllPlans was asked for, in which case Plans is set instead.
+type ExplainResult struct {
+	Plan      *ExplainPlan   `json:"plan"`
+	Plans     []ExplainPlan  `json:"plans"`
+	Cacheable bool           `json:"cacheable"`
+	Warnings  []QueryWarning `json:"warnings"`
+}
+
+//ExplainPlan is an execution plan of an AQL query.
+type ExplainPlan struct {
+	Nodes            []PlanNode       `json:"nodes"`
+	Rules            []string         `json:"rules"`
+	Collections      []PlanCollection `json:"collections"`
+	Variables        []PlanVariable   `json:"variables"`
+	EstimatedCost    float64          `json:"estimatedCost"`
+	EstimatedNrItems int              `json:"estimatedNrItems"`
+}
+
+//PlanNode is a single node of an execution plan. Only the
+//attributes shared by most node types are decoded. Type is
+//one of the NODE_* constants.
+type PlanNode struct {
+	Id               int     `json:"id"`
+	Type             string  `json:"type"`
+	Dependencies     []int   `json:"dependencies"`
+	EstimatedCost    float64 `json:"estimatedCost"`
+	EstimatedNrItems int     `json:"estimatedNrItems"`
+
+	//Set for nodes that read from or write to a collection.
+	Database   string `json:"database,omitempty"`
+	Collection string `json:"collection,omitempty"`
+
+	//Random is set on an EnumerateCollectionNode that was
+	//asked to return documents in random order.
+	Random bool `json:"random,omitempty"`
+
+	//Indexes are the indexes an IndexNode uses.
+	Indexes []Index `json:"indexes,omitempty"`
+}
+
+//PlanCollection is a collection used by an execution plan
+//and whether it is read or written.
+type PlanCollection struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+//PlanVariable is a variable used by an execution plan.
+type PlanVariable struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+//NodesOfType returns the nodes of the plan with the given type.
+func (p *ExplainPlan) NodesOfType(nodeType string) []PlanNode {
+	var nodes []PlanNode
+	for _, n := range p.Nodes {
+		if n.Type == nodeType {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+//CollectionScans returns the collections the plan reads in
+//full instead of using an index.
+func (p *ExplainPlan) CollectionScans() []string {
+	var collections []string
+	for _, n := range p.NodesOfType(NODE_ENUMERATE_COLLECTION) {
+		collections = append(collections, n.Collection)
+	}
+	return collections
+}
+
+//ParsedQuery is what ParseQuery returns.
+type ParsedQuery struct {
+	//Collections are the collections the query refers to.
+	Collections []string `json:"collections"`
+
+	//BindVars are the names of the bind parameters the query
+	//uses. Collection bind parameters start with @.
+	BindVars []string `json:"bindVars"`
+
+	//Ast is the abstract syntax tree of the query. It is left
+	//undecoded since its shape depends on the query.
+	Ast []map[string]interface{} `json:"ast"`
+}
+
+//QueryParseError is returned by ParseQuery, ValidateQuery and
+//ExplainQuery when the query has a syntax error. Line and Column
+//point at the error and are 0 when arango didn't say where it is.
+type QueryParseError struct {
+	ArangoError
+	Line   int
+	Column int
+}
+
+//Unwrap returns the ArangoError so errors.As finds it.
+func (e QueryParseError) Unwrap() error {
+	return e.ArangoError
+}
+
+var queryParsePosition = regexp.MustCompile(`at position (\d+):(\d+)`)
+
+//queryError turns parse errors into a QueryParseError.
+func queryError(e ArangoError) error {
+	if e.ErrorNum != ERROR_QUERY_PARSE {
+		return e
+	}
+
+	parseError := QueryParseError{ArangoError: e}
+	if m := queryParsePosition.FindStringSubmatch(e.ErrorMessage); m != nil {
+		parseError.Line, _ = strconv.Atoi(m[1])
+		parseError.Column, _ = strconv.Atoi(m[2])
+	}
+	return parseError
+}
+
+type explainQuery struct {
+	Query    string                 `json:"query"`
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	Options  *ExplainOptions        `json:"options,omitempty"`
+}
+
+//ExplainQuery asks arango how it would run query without
+//running it, using the POST /_api/explain endpoint. Only the
+//query string and bind variables of query are used. Options
+//can be nil.
+func (db *Database) ExplainQuery(query *AqlQuery, options *ExplainOptions) (*ExplainResult, error) {
+	return db.ExplainQueryCtx(context.Background(), query, options)
+}
+
+//ExplainQueryCtx is like ExplainQuery but the request is bound to ctx.
+func (db *Database) ExplainQueryCtx(ctx context.Context, query *AqlQuery, options *ExplainOptions) (*ExplainResult, error) {
+
+	if query == nil || query.Query == "" {
+		return nil, newError("You must provide a query string when calling ExplainQuery.")
+	}
+
+	payload := explainQuery{
+		Query:    query.Query,
+		BindVars: query.BindVars,
+		Options:  options,
+	}
+
+	var result ExplainResult
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/explain",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, &payload, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return &result, nil
+	default:
+		return nil, queryError(e)
+	}
+}
+
+//ParseQuery checks the syntax of query without running it, using
+//the POST /_api/query endpoint. Syntax errors are returned as a
+//QueryParseError.
+func (db *Database) ParseQuery(query string) (*ParsedQuery, error) {
+	return db.ParseQueryCtx(context.Background(), query)
+}
+
+//ParseQueryCtx is like ParseQuery but the request is bound to ctx.
+func (db *Database) ParseQueryCtx(ctx context.Context, query string) (*ParsedQuery, error) {
+
+	if query == "" {
+		return nil, newError("You must provide a query string when calling ParseQuery.")
+	}
+
+	payload := map[string]string{"query": query}
+
+	var result ParsedQuery
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/query",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, &payload, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return &result, nil
+	default:
+		return nil, queryError(e)
+	}
+}
+
+//ValidateQuery is like ParseQuery but only returns the error,
+//if any.
+func (db *Database) ValidateQuery(query string) error {
+	return db.ValidateQueryCtx(context.Background(), query)
+}
+
+//ValidateQueryCtx is like ValidateQuery but the request is bound to ctx.
+func (db *Database) ValidateQueryCtx(ctx context.Context, query string) error {
+	_, err := db.ParseQueryCtx(ctx, query)
+	return err
+}
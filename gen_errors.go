@@ -0,0 +1,111 @@
+//go:build ignore
+// +build ignore
+
+//gen_errors generates error_codes.go from the errors.dat file found
+//in the arangodb source tree under lib/Basics/errors.dat.
+//
+//  go run gen_errors.go -in /path/to/arangodb/lib/Basics/errors.dat
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type arangoError struct {
+	name        string
+	num         int
+	message     string
+	description string
+}
+
+func main() {
+	in := flag.String("in", "errors.dat", "path to arangodb's errors.dat")
+	out := flag.String("out", "error_codes.go", "file to write")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+
+	var errs []arangoError
+	seen := make(map[int]bool)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(record) < 4 {
+			continue
+		}
+		num, err := strconv.Atoi(strings.TrimSpace(record[1]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if seen[num] {
+			log.Fatalf("error number %d is used twice", num)
+		}
+		seen[num] = true
+		errs = append(errs, arangoError{
+			name:        strings.TrimSpace(record[0]),
+			num:         num,
+			message:     record[2],
+			description: strings.TrimSpace(record[3]),
+		})
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].num < errs[j].num })
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen_errors.go from arangodb's lib/Basics/errors.dat. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package arango")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "//Error numbers arangodb returns in the errorNum field of its responses.")
+	fmt.Fprintln(&b, "//Compare them with ArangoError.ErrorNum or use ErrorName to print them.")
+	fmt.Fprintln(&b, "const (")
+	for _, e := range errs {
+		fmt.Fprintf(&b, "//%s\n", e.description)
+		fmt.Fprintf(&b, "%s = %d\n", e.name, e.num)
+	}
+	fmt.Fprintln(&b, ")")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var errorNames = map[int]string{")
+	for _, e := range errs {
+		fmt.Fprintf(&b, "%d: %q,\n", e.num, e.name)
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var errorMessages = map[int]string{")
+	for _, e := range errs {
+		fmt.Fprintf(&b, "%d: %q,\n", e.num, e.message)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
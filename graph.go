@@ -0,0 +1,715 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+	na "github.com/jmcvetta/napping"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+//EdgeDefinition tells a graph which vertex collections the
+//edges of an edge collection may connect.
+type EdgeDefinition struct {
+	Collection string   `json:"collection"`
+	From       []string `json:"from"`
+	To         []string `json:"to"`
+}
+
+//Graph represents a named graph managed through the /_api/gharial endpoints.
+//Don't instantiate this yourself. Use db.CreateGraph or db.Graph
+//to get the one you want.
+//
+//Vertices and edges changed through a Graph are checked against the
+//edge definitions, and deleting a vertex also deletes its edges,
+//which the plain document and edge methods don't do.
+//
+//A Graph is safe for concurrent use by multiple goroutines.
+type Graph struct {
+	db   *Database
+	mu   sync.RWMutex
+	json *graphResult
+}
+
+type graphResult struct {
+	//Older servers only name the graph through its key
+	Name              string           `json:"name"`
+	Key               string           `json:"_key"`
+	Id                string           `json:"_id"`
+	Rev               string           `json:"_rev"`
+	EdgeDefinitions   []EdgeDefinition `json:"edgeDefinitions"`
+	OrphanCollections []string         `json:"orphanCollections"`
+}
+
+//Small internal types used when talking to /_api/gharial
+type createGraph struct {
+	Name              string           `json:"name"`
+	EdgeDefinitions   []EdgeDefinition `json:"edgeDefinitions"`
+	OrphanCollections []string         `json:"orphanCollections"`
+}
+
+type graphResponse struct {
+	Graph *graphResult `json:"graph"`
+}
+
+type graphsResponse struct {
+	Graphs []*graphResult `json:"graphs"`
+}
+
+type graphCollectionsResponse struct {
+	Collections []string `json:"collections"`
+}
+
+type vertexResponse struct {
+	Vertex interface{} `json:"vertex"`
+}
+
+type edgeResponse struct {
+	Edge interface{} `json:"edge"`
+}
+
+func (db *Database) newGraph(result *graphResult) *Graph {
+	if result == nil {
+		result = new(graphResult)
+	}
+	if result.Name == "" {
+		result.Name = result.Key
+	}
+	return &Graph{db: db, json: result}
+}
+
+//CreateGraph creates a new named graph using the POST /_api/gharial endpoint.
+//Collections named in the edge definitions and orphanCollections
+//are created if they don't exist. Both can be nil.
+func (db *Database) CreateGraph(name string, edgeDefinitions []EdgeDefinition, orphanCollections []string) (*Graph, error) {
+	return db.CreateGraphCtx(context.Background(), name, edgeDefinitions, orphanCollections)
+}
+
+//CreateGraphCtx is like CreateGraph but the request is bound to ctx.
+func (db *Database) CreateGraphCtx(ctx context.Context, name string, edgeDefinitions []EdgeDefinition, orphanCollections []string) (*Graph, error) {
+
+	if name == "" {
+		return nil, newError("You must specify a name when creating a graph.")
+	}
+
+	if edgeDefinitions == nil {
+		edgeDefinitions = []EdgeDefinition{}
+	}
+
+	if orphanCollections == nil {
+		orphanCollections = []string{}
+	}
+
+	var result graphResponse
+
+	err := db.gharial(ctx, "POST", "", nil, nil, &createGraph{
+		Name:              name,
+		EdgeDefinitions:   edgeDefinitions,
+		OrphanCollections: orphanCollections,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return db.newGraph(result.Graph), nil
+}
+
+//Graph gets a named graph from the database.
+//An error with a code 404 is returned if it doesn't exist.
+func (db *Database) Graph(name string) (*Graph, error) {
+	return db.GraphCtx(context.Background(), name)
+}
+
+//GraphCtx is like Graph but the request is bound to ctx.
+func (db *Database) GraphCtx(ctx context.Context, name string) (*Graph, error) {
+
+	if name == "" {
+		return nil, newError("You must specify a name when fetching a graph.")
+	}
+
+	var result graphResponse
+
+	err := db.gharial(ctx, "GET", "/"+url.PathEscape(name), nil, nil, nil, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return db.newGraph(result.Graph), nil
+}
+
+//Graphs returns all named graphs of the database.
+func (db *Database) Graphs() ([]*Graph, error) {
+	return db.GraphsCtx(context.Background())
+}
+
+//GraphsCtx is like Graphs but the request is bound to ctx.
+func (db *Database) GraphsCtx(ctx context.Context) ([]*Graph, error) {
+
+	var result graphsResponse
+
+	err := db.gharial(ctx, "GET", "", nil, nil, nil, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var graphs = make([]*Graph, len(result.Graphs))
+	for i, g := range result.Graphs {
+		graphs[i] = db.newGraph(g)
+	}
+
+	return graphs, nil
+}
+
+//DropGraph deletes a named graph. If dropCollections is true then the
+//collections that aren't used by any other graph are dropped too.
+func (db *Database) DropGraph(name string, dropCollections bool) error {
+	return db.DropGraphCtx(context.Background(), name, dropCollections)
+}
+
+//DropGraphCtx is like DropGraph but the request is bound to ctx.
+func (db *Database) DropGraphCtx(ctx context.Context, name string, dropCollections bool) error {
+
+	if name == "" {
+		return newError("You must specify a name when dropping a graph.")
+	}
+
+	var query url.Values = make(url.Values)
+	query.Add("dropCollections", fmt.Sprintf("%t", dropCollections))
+
+	return db.gharial(ctx, "DELETE", "/"+url.PathEscape(name), query, nil, nil, &struct{}{})
+}
+
+//result returns the cached graph information.
+func (g *Graph) result() *graphResult {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.json
+}
+
+//setResult replaces the cached graph information.
+func (g *Graph) setResult(result *graphResult) {
+	if result == nil {
+		return
+	}
+	if result.Name == "" {
+		result.Name = result.Key
+	}
+	g.mu.Lock()
+	g.json = result
+	g.mu.Unlock()
+}
+
+//Name returns the name of the graph.
+func (g *Graph) Name() string {
+	return g.result().Name
+}
+
+//EdgeDefinitions returns the edge definitions of the graph.
+//The result is cached. Call Refresh() to update it.
+func (g *Graph) EdgeDefinitions() []EdgeDefinition {
+	return g.result().EdgeDefinitions
+}
+
+//OrphanCollections returns the vertex collections of the graph
+//that aren't part of any edge definition.
+//The result is cached. Call Refresh() to update it.
+func (g *Graph) OrphanCollections() []string {
+	return g.result().OrphanCollections
+}
+
+//Refresh fetches the graph again to update the cached
+//edge definitions and orphan collections.
+func (g *Graph) Refresh() error {
+	return g.RefreshCtx(context.Background())
+}
+
+//RefreshCtx is like Refresh but the request is bound to ctx.
+func (g *Graph) RefreshCtx(ctx context.Context) error {
+	var result graphResponse
+	if err := g.request(ctx, "GET", "", nil, nil, nil, &result); err != nil {
+		return err
+	}
+	g.setResult(result.Graph)
+	return nil
+}
+
+//Drop deletes the graph. See db.DropGraph.
+//DO NOT expect the graph to work after dropping it.
+func (g *Graph) Drop(dropCollections bool) error {
+	return g.DropCtx(context.Background(), dropCollections)
+}
+
+//DropCtx is like Drop but the request is bound to ctx.
+func (g *Graph) DropCtx(ctx context.Context, dropCollections bool) error {
+	return g.db.DropGraphCtx(ctx, g.Name(), dropCollections)
+}
+
+//VertexCollections returns the names of all vertex collections of the
+//graph, both the ones used in edge definitions and the orphans.
+func (g *Graph) VertexCollections() ([]string, error) {
+	return g.VertexCollectionsCtx(context.Background())
+}
+
+//VertexCollectionsCtx is like VertexCollections but the request is bound to ctx.
+func (g *Graph) VertexCollectionsCtx(ctx context.Context) ([]string, error) {
+	var result graphCollectionsResponse
+	if err := g.request(ctx, "GET", "/vertex", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Collections, nil
+}
+
+//AddVertexCollection adds an orphan vertex collection to the graph.
+//The collection is created if it doesn't exist.
+func (g *Graph) AddVertexCollection(collectionName string) error {
+	return g.AddVertexCollectionCtx(context.Background(), collectionName)
+}
+
+//AddVertexCollectionCtx is like AddVertexCollection but the request is bound to ctx.
+func (g *Graph) AddVertexCollectionCtx(ctx context.Context, collectionName string) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when adding a vertex collection.")
+	}
+
+	var payload = struct {
+		Collection string `json:"collection"`
+	}{collectionName}
+
+	return g.update(ctx, "POST", "/vertex", nil, &payload)
+}
+
+//RemoveVertexCollection removes an orphan vertex collection from the graph.
+//Collections used in edge definitions can't be removed this way.
+//If dropCollection is true then the collection is dropped too.
+func (g *Graph) RemoveVertexCollection(collectionName string, dropCollection bool) error {
+	return g.RemoveVertexCollectionCtx(context.Background(), collectionName, dropCollection)
+}
+
+//RemoveVertexCollectionCtx is like RemoveVertexCollection but the request is bound to ctx.
+func (g *Graph) RemoveVertexCollectionCtx(ctx context.Context, collectionName string, dropCollection bool) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when removing a vertex collection.")
+	}
+
+	var query url.Values = make(url.Values)
+	query.Add("dropCollection", fmt.Sprintf("%t", dropCollection))
+
+	return g.update(ctx, "DELETE", "/vertex/"+url.PathEscape(collectionName), query, nil)
+}
+
+//EdgeCollections returns the names of the edge collections of the graph.
+func (g *Graph) EdgeCollections() ([]string, error) {
+	return g.EdgeCollectionsCtx(context.Background())
+}
+
+//EdgeCollectionsCtx is like EdgeCollections but the request is bound to ctx.
+func (g *Graph) EdgeCollectionsCtx(ctx context.Context) ([]string, error) {
+	var result graphCollectionsResponse
+	if err := g.request(ctx, "GET", "/edge", nil, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Collections, nil
+}
+
+//AddEdgeDefinition adds an edge definition to the graph.
+//Collections it names are created if they don't exist.
+func (g *Graph) AddEdgeDefinition(definition EdgeDefinition) error {
+	return g.AddEdgeDefinitionCtx(context.Background(), definition)
+}
+
+//AddEdgeDefinitionCtx is like AddEdgeDefinition but the request is bound to ctx.
+func (g *Graph) AddEdgeDefinitionCtx(ctx context.Context, definition EdgeDefinition) error {
+
+	if definition.Collection == "" {
+		return newError("You must specify a collection when adding an edge definition.")
+	}
+
+	return g.update(ctx, "POST", "/edge", nil, &definition)
+}
+
+//ReplaceEdgeDefinition replaces the edge definition for
+//definition.Collection. This changes every graph using that
+//edge collection, not just this one.
+func (g *Graph) ReplaceEdgeDefinition(definition EdgeDefinition) error {
+	return g.ReplaceEdgeDefinitionCtx(context.Background(), definition)
+}
+
+//ReplaceEdgeDefinitionCtx is like ReplaceEdgeDefinition but the request is bound to ctx.
+func (g *Graph) ReplaceEdgeDefinitionCtx(ctx context.Context, definition EdgeDefinition) error {
+
+	if definition.Collection == "" {
+		return newError("You must specify a collection when replacing an edge definition.")
+	}
+
+	return g.update(ctx, "PUT", "/edge/"+url.PathEscape(definition.Collection), nil, &definition)
+}
+
+//RemoveEdgeDefinition removes the edge definition of an edge collection
+//from the graph. If dropCollection is true then the edge collection
+//is dropped too.
+func (g *Graph) RemoveEdgeDefinition(collectionName string, dropCollection bool) error {
+	return g.RemoveEdgeDefinitionCtx(context.Background(), collectionName, dropCollection)
+}
+
+//RemoveEdgeDefinitionCtx is like RemoveEdgeDefinition but the request is bound to ctx.
+func (g *Graph) RemoveEdgeDefinitionCtx(ctx context.Context, collectionName string, dropCollection bool) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when removing an edge definition.")
+	}
+
+	var query url.Values = make(url.Values)
+	query.Add("dropCollection", fmt.Sprintf("%t", dropCollection))
+
+	return g.update(ctx, "DELETE", "/edge/"+url.PathEscape(collectionName), query, nil)
+}
+
+//SaveVertex creates a vertex in collectionName, which must be one of the
+//vertex collections of the graph. The document is populated with the
+//Id, Rev and Key if it has fields for them. options can be nil.
+//Only WaitForSync of the options is used.
+func (g *Graph) SaveVertex(collectionName string, document interface{}, options *SaveOptions) error {
+	return g.SaveVertexCtx(context.Background(), collectionName, document, options)
+}
+
+//SaveVertexCtx is like SaveVertex but the request is bound to ctx.
+func (g *Graph) SaveVertexCtx(ctx context.Context, collectionName string, document interface{}, options *SaveOptions) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when saving a vertex.")
+	}
+
+	var query url.Values = make(url.Values)
+	if options != nil {
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+	}
+
+	return g.request(ctx, "POST", "/vertex/"+url.PathEscape(collectionName), query, nil, document, &vertexResponse{Vertex: document})
+}
+
+//Vertex fetches a vertex of the graph. documentHandle must be a full
+//document id or implement HasArangoId. options can be nil.
+func (g *Graph) Vertex(documentHandle, document interface{}, options *GetOptions) error {
+	return g.VertexCtx(context.Background(), documentHandle, document, options)
+}
+
+//VertexCtx is like Vertex but the request is bound to ctx.
+func (g *Graph) VertexCtx(ctx context.Context, documentHandle, document interface{}, options *GetOptions) error {
+	return g.getElement(ctx, "vertex", documentHandle, options, &vertexResponse{Vertex: document})
+}
+
+//ReplaceVertex replaces a vertex of the graph. The Rev of the document
+//handle, options.Rev and options.IfMatch are all sent as If-Match.
+//options can be nil. Policy is ignored.
+func (g *Graph) ReplaceVertex(documentHandle, document interface{}, options *ReplaceOptions) error {
+	return g.ReplaceVertexCtx(context.Background(), documentHandle, document, options)
+}
+
+//ReplaceVertexCtx is like ReplaceVertex but the request is bound to ctx.
+func (g *Graph) ReplaceVertexCtx(ctx context.Context, documentHandle, document interface{}, options *ReplaceOptions) error {
+	return g.replaceElement(ctx, "vertex", documentHandle, document, options, &vertexResponse{Vertex: document})
+}
+
+//UpdateVertex partially updates a vertex of the graph. See ReplaceVertex
+//for how revisions are checked. options can be nil.
+func (g *Graph) UpdateVertex(documentHandle, document interface{}, options *UpdateOptions) error {
+	return g.UpdateVertexCtx(context.Background(), documentHandle, document, options)
+}
+
+//UpdateVertexCtx is like UpdateVertex but the request is bound to ctx.
+func (g *Graph) UpdateVertexCtx(ctx context.Context, documentHandle, document interface{}, options *UpdateOptions) error {
+	return g.updateElement(ctx, "vertex", documentHandle, document, options, &vertexResponse{Vertex: document})
+}
+
+//DeleteVertex deletes a vertex of the graph along with
+//every edge of the graph that starts or ends at it.
+//options can be nil.
+func (g *Graph) DeleteVertex(documentHandle interface{}, options *DeleteOptions) error {
+	return g.DeleteVertexCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteVertexCtx is like DeleteVertex but the request is bound to ctx.
+func (g *Graph) DeleteVertexCtx(ctx context.Context, documentHandle interface{}, options *DeleteOptions) error {
+	return g.deleteElement(ctx, "vertex", documentHandle, options)
+}
+
+//SaveEdge creates an edge in collectionName between from and to, which
+//must be allowed by the edge definitions of the graph. from and to must be
+//full document ids or implement HasArangoId. If edge implements ArangoEdge
+//its From and To are set before saving. options can be nil.
+//Only WaitForSync of the options is used.
+func (g *Graph) SaveEdge(collectionName string, from, to, edge interface{}, options *SaveOptions) error {
+	return g.SaveEdgeCtx(context.Background(), collectionName, from, to, edge, options)
+}
+
+//SaveEdgeCtx is like SaveEdge but the request is bound to ctx.
+func (g *Graph) SaveEdgeCtx(ctx context.Context, collectionName string, from, to, edge interface{}, options *SaveOptions) error {
+
+	if collectionName == "" {
+		return newError("You must specify a collection name when saving an edge.")
+	}
+
+	fromId, _, err := resolveHandle(from)
+
+	if err != nil {
+		return newError("The \"from\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	toId, _, err := resolveHandle(to)
+
+	if err != nil {
+		return newError("The \"to\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	var payload interface{} = edge
+
+	switch e := edge.(type) {
+	case ArangoEdge:
+		e.SetFrom(fromId)
+		e.SetTo(toId)
+	case nil:
+		payload = &EdgeImplementation{ArangoFrom: fromId, ArangoTo: toId}
+	default:
+		return newError("The edge must implement ArangoEdge so _from and _to can be set. Embed EdgeImplementation.")
+	}
+
+	var query url.Values = make(url.Values)
+	if options != nil {
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+	}
+
+	return g.request(ctx, "POST", "/edge/"+url.PathEscape(collectionName), query, nil, payload, &edgeResponse{Edge: edge})
+}
+
+//Edge fetches an edge of the graph. documentHandle must be a full
+//document id or implement HasArangoId. options can be nil.
+func (g *Graph) Edge(documentHandle, edge interface{}, options *GetOptions) error {
+	return g.EdgeCtx(context.Background(), documentHandle, edge, options)
+}
+
+//EdgeCtx is like Edge but the request is bound to ctx.
+func (g *Graph) EdgeCtx(ctx context.Context, documentHandle, edge interface{}, options *GetOptions) error {
+	return g.getElement(ctx, "edge", documentHandle, options, &edgeResponse{Edge: edge})
+}
+
+//ReplaceEdge replaces an edge of the graph. The new _from and _to
+//are checked against the edge definitions. See ReplaceVertex for
+//how revisions are checked. options can be nil.
+func (g *Graph) ReplaceEdge(documentHandle, edge interface{}, options *ReplaceOptions) error {
+	return g.ReplaceEdgeCtx(context.Background(), documentHandle, edge, options)
+}
+
+//ReplaceEdgeCtx is like ReplaceEdge but the request is bound to ctx.
+func (g *Graph) ReplaceEdgeCtx(ctx context.Context, documentHandle, edge interface{}, options *ReplaceOptions) error {
+	return g.replaceElement(ctx, "edge", documentHandle, edge, options, &edgeResponse{Edge: edge})
+}
+
+//UpdateEdge partially updates an edge of the graph. See ReplaceVertex
+//for how revisions are checked. options can be nil.
+func (g *Graph) UpdateEdge(documentHandle, edge interface{}, options *UpdateOptions) error {
+	return g.UpdateEdgeCtx(context.Background(), documentHandle, edge, options)
+}
+
+//UpdateEdgeCtx is like UpdateEdge but the request is bound to ctx.
+func (g *Graph) UpdateEdgeCtx(ctx context.Context, documentHandle, edge interface{}, options *UpdateOptions) error {
+	return g.updateElement(ctx, "edge", documentHandle, edge, options, &edgeResponse{Edge: edge})
+}
+
+//DeleteEdge deletes an edge of the graph. options can be nil.
+func (g *Graph) DeleteEdge(documentHandle interface{}, options *DeleteOptions) error {
+	return g.DeleteEdgeCtx(context.Background(), documentHandle, options)
+}
+
+//DeleteEdgeCtx is like DeleteEdge but the request is bound to ctx.
+func (g *Graph) DeleteEdgeCtx(ctx context.Context, documentHandle interface{}, options *DeleteOptions) error {
+	return g.deleteElement(ctx, "edge", documentHandle, options)
+}
+
+//elementPath turns a document handle into the path of a
+//vertex or edge below the graph and the revision it carries.
+func elementPath(kind string, documentHandle interface{}) (string, string, error) {
+
+	id, rev, err := resolveHandle(documentHandle)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", newError(fmt.Sprintf("The %s handle must be a full document id like collection/key. (%s)", kind, id))
+	}
+
+	return fmt.Sprintf("/%s/%s/%s", kind, url.PathEscape(parts[0]), url.PathEscape(parts[1])), rev, nil
+}
+
+//revisionHeader builds the If-Match header from the revision of the
+//handle or the options, in that order.
+func revisionHeader(revs ...string) http.Header {
+	var header = make(http.Header)
+	for _, rev := range revs {
+		if rev != "" {
+			header.Set("If-Match", rev)
+			break
+		}
+	}
+	return header
+}
+
+func (g *Graph) getElement(ctx context.Context, kind string, documentHandle interface{}, options *GetOptions, result interface{}) error {
+
+	path, rev, err := elementPath(kind, documentHandle)
+
+	if err != nil {
+		return err
+	}
+
+	var header = make(http.Header)
+	if options != nil {
+		if options.IfNoneMatch != "" {
+			header.Set("If-None-Match", options.IfNoneMatch)
+		}
+		if options.IfMatch != "" {
+			header.Set("If-Match", options.IfMatch)
+		}
+	}
+	if rev != "" {
+		header.Set("If-Match", rev)
+	}
+
+	return g.request(ctx, "GET", path, nil, header, nil, result)
+}
+
+func (g *Graph) replaceElement(ctx context.Context, kind string, documentHandle, document interface{}, options *ReplaceOptions, result interface{}) error {
+
+	path, rev, err := elementPath(kind, documentHandle)
+
+	if err != nil {
+		return err
+	}
+
+	var query url.Values = make(url.Values)
+	var header http.Header
+
+	if options != nil {
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		header = revisionHeader(rev, options.IfMatch, options.Rev)
+	} else {
+		header = revisionHeader(rev)
+	}
+
+	return g.request(ctx, "PUT", path, query, header, document, result)
+}
+
+func (g *Graph) updateElement(ctx context.Context, kind string, documentHandle, document interface{}, options *UpdateOptions, result interface{}) error {
+
+	path, rev, err := elementPath(kind, documentHandle)
+
+	if err != nil {
+		return err
+	}
+
+	var query url.Values = make(url.Values)
+	var header http.Header
+
+	if options != nil {
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		query.Add("keepNull", fmt.Sprintf("%t", options.KeepNull))
+		header = revisionHeader(rev, options.IfMatch, options.Rev)
+	} else {
+		header = revisionHeader(rev)
+	}
+
+	return g.request(ctx, "PATCH", path, query, header, document, result)
+}
+
+func (g *Graph) deleteElement(ctx context.Context, kind string, documentHandle interface{}, options *DeleteOptions) error {
+
+	path, rev, err := elementPath(kind, documentHandle)
+
+	if err != nil {
+		return err
+	}
+
+	var query url.Values = make(url.Values)
+	var header http.Header
+
+	if options != nil {
+		query.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+		header = revisionHeader(rev, options.IfMatch, options.Rev)
+	} else {
+		header = revisionHeader(rev)
+	}
+
+	return g.request(ctx, "DELETE", path, query, header, nil, &struct{}{})
+}
+
+//update sends a request that changes the graph itself and
+//refreshes the cached information with the graph arango returns.
+func (g *Graph) update(ctx context.Context, method, path string, query url.Values, payload interface{}) error {
+	var result graphResponse
+	if err := g.request(ctx, method, path, query, nil, payload, &result); err != nil {
+		return err
+	}
+	g.setResult(result.Graph)
+	return nil
+}
+
+//request sends a request to a path below /_api/gharial/{graph-name}.
+func (g *Graph) request(ctx context.Context, method, path string, query url.Values, header http.Header, payload, result interface{}) error {
+	return g.db.gharial(ctx, method, "/"+url.PathEscape(g.Name())+path, query, header, payload, result)
+}
+
+//gharial sends one request to the /_api/gharial endpoints.
+func (db *Database) gharial(ctx context.Context, method, path string, query url.Values, header http.Header, payload, result interface{}) error {
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/gharial%s", db.serverUrl.String(), path)
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var session *na.Session
+	if header != nil {
+		session = db.sessionWithHeader(ctx, header)
+	} else {
+		session = db.sessionCtx(ctx)
+	}
+
+	var response *na.Response
+	var err error
+
+	switch method {
+	case "GET":
+		response, err = session.Get(endpoint, nil, result, &e)
+	case "POST":
+		response, err = session.Post(endpoint, payload, result, &e)
+	case "PUT":
+		response, err = session.Put(endpoint, payload, result, &e)
+	case "PATCH":
+		response, err = session.Patch(endpoint, payload, result, &e)
+	case "DELETE":
+		response, err = session.Delete(endpoint, result, &e)
+	}
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200, 201, 202, 304:
+		return nil
+	default:
+		return e
+	}
+}
@@ -0,0 +1,262 @@
+package arango
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	setup()
+	defer teardown()
+
+	g, err := db.CreateGraph("social", []EdgeDefinition{
+		{Collection: "knows", From: []string{"people"}, To: []string{"people"}},
+	}, []string{"places"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Name() != "social" || len(g.EdgeDefinitions()) != 1 || g.OrphanCollections()[0] != "places" {
+		t.Fatalf("Unexpected graph %s %+v %v", g.Name(), g.EdgeDefinitions(), g.OrphanCollections())
+	}
+
+	if _, err = db.CreateGraph("social", nil, nil); !IsConflict(err) {
+		t.Fatalf("Expected a conflict when creating the graph twice but got %v", err)
+	}
+
+	fetched, err := db.Graph("social")
+
+	if err != nil || fetched.Name() != "social" {
+		t.Fatalf("Expected to fetch the graph: %v", err)
+	}
+
+	graphs, err := db.Graphs()
+
+	if err != nil || len(graphs) != 1 {
+		t.Fatalf("Expected one graph: %v %v", graphs, err)
+	}
+
+	if err = g.AddVertexCollection("things"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.AddEdgeDefinition(EdgeDefinition{Collection: "visited", From: []string{"people"}, To: []string{"places"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g.EdgeDefinitions()) != 2 {
+		t.Fatalf("Expected the cached edge definitions to be refreshed: %+v", g.EdgeDefinitions())
+	}
+
+	vertices, err := g.VertexCollections()
+
+	if err != nil || len(vertices) != 3 {
+		t.Fatalf("Expected 3 vertex collections: %v %v", vertices, err)
+	}
+
+	edges, err := g.EdgeCollections()
+
+	if err != nil || len(edges) != 2 {
+		t.Fatalf("Expected 2 edge collections: %v %v", edges, err)
+	}
+
+	alice, bob := &DummyFullDocument{Hi: "alice"}, &DummyFullDocument{Hi: "bob"}
+
+	if err = g.SaveVertex("people", alice, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.SaveVertex("people", bob, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if alice.Id() == "" || alice.Rev() == "" {
+		t.Fatalf("Expected the vertex to be populated: %+v", alice)
+	}
+
+	knows := &EdgeImplementation{}
+
+	if err = g.SaveEdge("knows", alice, bob.Id(), knows, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if knows.Id() == "" || knows.From() != alice.Id() || knows.To() != bob.Id() {
+		t.Fatalf("Expected the edge to be populated: %+v", knows)
+	}
+
+	if err = g.SaveEdge("visited", alice, bob, nil, nil); err == nil {
+		t.Fatal("Expected an error when the edge definition doesn't allow the edge.")
+	}
+
+	alice.Hi = "alice2"
+
+	if err = g.UpdateVertex(alice, alice, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var fetchedVertex DummyFullDocument
+
+	if err = g.Vertex(alice.Id(), &fetchedVertex, nil); err != nil || fetchedVertex.Hi != "alice2" {
+		t.Fatalf("Expected the updated vertex: %+v %v", fetchedVertex, err)
+	}
+
+	stale := &DummyFullDocument{Hi: "stale"}
+	stale.SetId(alice.Id())
+	stale.SetRev("1")
+
+	if err = g.ReplaceVertex(stale, stale, nil); !IsConflict(err) {
+		t.Fatalf("Expected a conflict when replacing with an old revision but got %v", err)
+	}
+
+	if err = g.DeleteVertex(alice, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.Edge(knows.Id(), &EdgeImplementation{}, nil); !IsNotFound(err) {
+		t.Fatalf("Expected the edge to be removed with its vertex but got %v", err)
+	}
+
+	if err = g.RemoveEdgeDefinition("visited", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.RemoveVertexCollection("things", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.Drop(true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Graph("social"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error after dropping the graph but got %v", err)
+	}
+}
+
+func TestGraphRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api", "If-Match")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/vertex/people"):
+			if r.Method == "POST" {
+				w.WriteHeader(202)
+			}
+			fmt.Fprint(w, `{"vertex":{"_id":"people/1","_key":"1","_rev":"10","Hi":"there"},"error":false}`)
+		case strings.Contains(r.URL.Path, "/edge/knows"):
+			if r.Method == "DELETE" {
+				w.WriteHeader(412)
+				fmt.Fprint(w, `{"error":true,"code":412,"errorNum":1200,"errorMessage":"conflict"}`)
+				return
+			}
+			w.WriteHeader(202)
+			fmt.Fprint(w, `{"edge":{"_id":"knows/2","_key":"2","_rev":"20"},"error":false}`)
+		case r.URL.Path == "/_db/_system/_api/gharial":
+			if r.Method == "GET" {
+				fmt.Fprint(w, `{"graphs":[{"_key":"social","edgeDefinitions":[],"orphanCollections":[]}]}`)
+				return
+			}
+			w.WriteHeader(202)
+			fallthrough
+		default:
+			fmt.Fprint(w, `{"graph":{"_key":"social","edgeDefinitions":[{"collection":"knows","from":["people"],"to":["people"]}],"orphanCollections":["places"]}}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.CreateGraph("", nil, nil); err == nil {
+		t.Fatal("Expected an error when creating a graph without a name.")
+	}
+
+	rec.reset()
+
+	g, err := db.CreateGraph("social", nil, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Name() != "social" || g.EdgeDefinitions()[0].Collection != "knows" {
+		t.Fatalf("Expected the graph to be decoded and named after its key: %s %+v", g.Name(), g.EdgeDefinitions())
+	}
+
+	graphs, err := db.Graphs()
+
+	if err != nil || len(graphs) != 1 || graphs[0].Name() != "social" {
+		t.Fatalf("Unexpected graphs %v %v", graphs, err)
+	}
+
+	g.AddVertexCollection("places")
+	g.RemoveEdgeDefinition("knows", false)
+
+	vertex := &DummyFullDocument{Hi: "there"}
+
+	if err = g.SaveVertex("people", vertex, &SaveOptions{WaitForSync: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if vertex.Id() != "people/1" || vertex.Rev() != "10" {
+		t.Fatalf("Expected the vertex to be populated: %+v", vertex)
+	}
+
+	if err = g.Vertex("1", vertex, nil); err == nil {
+		t.Fatal("Expected an error when the vertex handle is only a key.")
+	}
+
+	if err = g.UpdateVertex(vertex, map[string]string{"Hi": "you"}, &UpdateOptions{KeepNull: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.ReplaceVertex("people/1", vertex, &ReplaceOptions{IfMatch: "5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.SaveEdge("knows", vertex, "people/3", map[string]string{}, nil); err == nil {
+		t.Fatal("Expected an error when the edge doesn't implement ArangoEdge.")
+	}
+
+	edge := &EdgeImplementation{}
+
+	if err = g.SaveEdge("knows", vertex, "people/3", edge, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if edge.Id() != "knows/2" || edge.From() != "people/1" || edge.To() != "people/3" {
+		t.Fatalf("Expected the edge to be populated: %+v", edge)
+	}
+
+	if err = g.DeleteEdge(edge, nil); !IsConflict(err) {
+		t.Fatalf("Expected the precondition failure to be returned but got %v", err)
+	}
+
+	if err = g.DeleteVertex("people/1", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.Drop(true); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /gharial {"name":"social","edgeDefinitions":[],"orphanCollections":[]}`,
+		`GET /gharial`,
+		`POST /gharial/social/vertex {"collection":"places"}`,
+		`DELETE /gharial/social/edge/knows?dropCollection=false`,
+		`POST /gharial/social/vertex/people?waitForSync=true {"Hi":"there"}`,
+		`PATCH /gharial/social/vertex/people/1?keepNull=true&waitForSync=false If-Match:10 {"Hi":"you"}`,
+		`PUT /gharial/social/vertex/people/1?waitForSync=false If-Match:5 {"_id":"people/1","_rev":"10","_key":"1","Hi":"there"}`,
+		`POST /gharial/social/edge/knows {"_from":"people/1","_to":"people/3"}`,
+		`DELETE /gharial/social/edge/knows/2 If-Match:20`,
+		`DELETE /gharial/social/vertex/people/1`,
+		`DELETE /gharial/social?dropCollections=true`,
+	)
+}
@@ -0,0 +1,317 @@
+package arango
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+)
+
+//ImportOptions represent the options of the POST /_api/import endpoint.
+type ImportOptions struct {
+	//Type is either "documents", where every line holds one json
+	//document, or "array", where the body is one json array of
+	//documents. Defaults to "documents".
+	Type string
+
+	//OnDuplicate says what happens when a document with the same
+	//_key already exists. It can be "error", "update", "replace"
+	//or "ignore". Arango uses "error" if it is blank.
+	OnDuplicate string
+
+	//Complete makes arango abort the whole import if any document
+	//fails. With ChunkSize set this only applies per chunk.
+	Complete bool
+
+	//Details makes arango return a message for every document
+	//that failed in ImportResult.Details.
+	Details bool
+
+	WaitForSync bool
+
+	//ChunkSize is the number of documents sent per request.
+	//0 sends everything in a single request.
+	ChunkSize int
+}
+
+//ImportResult holds the counts arango returns after an import.
+//When the documents were sent in several chunks the counts are
+//added up. The positions in Details are relative to the chunk
+//the document was sent in.
+type ImportResult struct {
+	Created int      `json:"created"`
+	Errors  int      `json:"errors"`
+	Empty   int      `json:"empty"`
+	Updated int      `json:"updated"`
+	Ignored int      `json:"ignored"`
+	Details []string `json:"details"`
+}
+
+//Import saves many documents with one request per chunk using the
+//POST /_api/import endpoint. documents can be:
+//  * a slice or array of documents
+//  * a channel of documents. Import reads until it is closed.
+//  * an io.Reader or []byte holding json lines, or a json array
+//    when options.Type is "array".
+//The documents are written into each request as they are read so
+//a large reader or channel is never held in memory as a whole.
+//Options can be nil to use the defaults.
+func (c *Collection) Import(documents interface{}, options *ImportOptions) (*ImportResult, error) {
+	return c.ImportCtx(context.Background(), documents, options)
+}
+
+//ImportCtx is like Import but the requests are bound to ctx.
+//
+//Because the documents are streamed, a request that fails is not
+//sent again to another endpoint of the connection. The endpoint is
+//skipped from then on, so the import can be retried with the
+//documents that weren't imported yet.
+func (c *Collection) ImportCtx(ctx context.Context, documents interface{}, options *ImportOptions) (*ImportResult, error) {
+
+	if options == nil {
+		options = &ImportOptions{}
+	}
+
+	importType := options.Type
+	if importType == "" {
+		importType = "documents"
+	}
+
+	if importType != "documents" && importType != "array" {
+		return nil, newError(fmt.Sprintf("The import type %s is not supported. Use documents or array.", importType))
+	}
+
+	next, err := importSource(ctx, documents, importType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var values url.Values = make(url.Values)
+	values.Add("collection", c.Name())
+	values.Add("type", importType)
+	values.Add("complete", fmt.Sprintf("%t", options.Complete))
+	values.Add("details", fmt.Sprintf("%t", options.Details))
+	values.Add("waitForSync", fmt.Sprintf("%t", options.WaitForSync))
+
+	if options.OnDuplicate != "" {
+		values.Add("onDuplicate", options.OnDuplicate)
+	}
+
+	endpoint := fmt.Sprintf("%s/import?%s",
+		c.db.serverUrl.String(),
+		values.Encode(),
+	)
+
+	var total = new(ImportResult)
+
+	for {
+		//peek so an exhausted source doesn't send an empty request
+		first, err := next()
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+
+		//the documents are written into the request while it is
+		//being sent so they never have to be held in memory at once
+		body, writer := io.Pipe()
+		written := make(chan importChunk, 1)
+		go func() {
+			chunk := writeImport(writer, first, next, importType, options.ChunkSize)
+			writer.CloseWithError(chunk.err)
+			written <- chunk
+		}()
+
+		var result ImportResult
+		var e ArangoError
+
+		status, err := c.db.sendRaw(ctx, "POST", endpoint, nil, body, &result, &e)
+
+		//stops the writer if the server answered before reading everything
+		body.Close()
+		chunk := <-written
+
+		//a closed pipe only means the server stopped reading,
+		//its answer says why
+		if chunk.err != nil && chunk.err != io.ErrClosedPipe {
+			return total, chunk.err
+		}
+
+		if err != nil {
+			return total, wrapError(err)
+		}
+
+		switch status {
+		case 201:
+			total.Created += result.Created
+			total.Errors += result.Errors
+			total.Empty += result.Empty
+			total.Updated += result.Updated
+			total.Ignored += result.Ignored
+			total.Details = append(total.Details, result.Details...)
+		default:
+			return total, e
+		}
+
+		if chunk.err != nil {
+			return total, wrapError(chunk.err)
+		}
+
+		if chunk.done {
+			return total, nil
+		}
+	}
+}
+
+type importChunk struct {
+	//done is set once the source ran out of documents
+	done bool
+	err  error
+}
+
+//writeImport writes first and then up to chunkSize - 1 more
+//documents from next into w. A chunkSize of 0 or less writes
+//every document.
+func writeImport(w io.Writer, first []byte, next func() ([]byte, error), importType string, chunkSize int) importChunk {
+	separator := []byte("\n")
+	if importType == "array" {
+		separator = []byte(",")
+		if _, err := w.Write([]byte("[")); err != nil {
+			return importChunk{err: err}
+		}
+	}
+
+	var chunk importChunk
+	doc := first
+
+	for count := 1; ; count++ {
+		if _, err := w.Write(doc); err != nil {
+			return importChunk{err: err}
+		}
+
+		if chunkSize > 0 && count >= chunkSize {
+			break
+		}
+
+		var err error
+		doc, err = next()
+		if err == io.EOF {
+			chunk.done = true
+			break
+		}
+		if err != nil {
+			return importChunk{err: err}
+		}
+
+		if _, err = w.Write(separator); err != nil {
+			return importChunk{err: err}
+		}
+	}
+
+	if importType == "array" {
+		if _, err := w.Write([]byte("]")); err != nil {
+			return importChunk{err: err}
+		}
+	}
+
+	return chunk
+}
+
+//importSource returns a function that hands out the json of
+//one document at a time and io.EOF once there are no more.
+func importSource(ctx context.Context, documents interface{}, importType string) (func() ([]byte, error), error) {
+
+	if b, ok := documents.([]byte); ok {
+		documents = bytes.NewReader(b)
+	}
+
+	if r, ok := documents.(io.Reader); ok {
+		if importType == "array" {
+			//read one document at a time instead of the whole array
+			decoder := json.NewDecoder(r)
+			if t, err := decoder.Token(); err != nil || t != json.Delim('[') {
+				return nil, newError("The documents to import must be a json array when the type is array.")
+			}
+			finished := false
+			return func() ([]byte, error) {
+				if finished {
+					return nil, io.EOF
+				}
+				if !decoder.More() {
+					//the closing ]
+					if _, err := decoder.Token(); err != nil {
+						return nil, wrapError(err)
+					}
+					finished = true
+					return nil, io.EOF
+				}
+				var doc json.RawMessage
+				if err := decoder.Decode(&doc); err != nil {
+					return nil, wrapError(err)
+				}
+				return doc, nil
+			}, nil
+		}
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+		return func() ([]byte, error) {
+			for scanner.Scan() {
+				line := bytes.TrimSpace(scanner.Bytes())
+				if len(line) > 0 {
+					return append([]byte(nil), line...), nil
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, wrapError(err)
+			}
+			return nil, io.EOF
+		}, nil
+	}
+
+	v := reflect.ValueOf(documents)
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		i := 0
+		return func() ([]byte, error) {
+			if i >= v.Len() {
+				return nil, io.EOF
+			}
+			i++
+			return marshalImport(v.Index(i - 1).Interface())
+		}, nil
+	case reflect.Chan:
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: v},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		}
+		return func() ([]byte, error) {
+			chosen, doc, ok := reflect.Select(cases)
+			if chosen == 1 {
+				return nil, wrapError(ctx.Err())
+			}
+			if !ok {
+				return nil, io.EOF
+			}
+			return marshalImport(doc.Interface())
+		}, nil
+	}
+
+	return nil, newError("The documents to import must be a slice, an array, a channel or an io.Reader.")
+}
+
+func marshalImport(document interface{}) ([]byte, error) {
+	b, err := json.Marshal(document)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return b, nil
+}
@@ -0,0 +1,263 @@
+package arango
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("imports")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := []DummyFullDocument{
+		{DocumentImplementation: DocumentImplementation{ArangoKey: "a"}, Hi: "1"},
+		{DocumentImplementation: DocumentImplementation{ArangoKey: "b"}, Hi: "2"},
+		{DocumentImplementation: DocumentImplementation{ArangoKey: "c"}, Hi: "3"},
+	}
+
+	result, err := c.Import(docs, &ImportOptions{ChunkSize: 2})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created != 3 || result.Errors != 0 {
+		t.Fatalf("Expected 3 documents to be created: %+v", result)
+	}
+
+	//duplicates are reported per line
+	result, err = c.Import(strings.NewReader(`{"_key":"a","Hi":"x"}`+"\n"+`{"_key":"d","Hi":"4"}`), &ImportOptions{Details: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created != 1 || result.Errors != 1 || len(result.Details) != 1 {
+		t.Fatalf("Expected one document to fail: %+v", result)
+	}
+
+	//and can be updated instead
+	ch := make(chan interface{})
+	go func() {
+		ch <- map[string]string{"_key": "a", "Hi": "updated"}
+		ch <- map[string]string{"_key": "e", "Hi": "5"}
+		close(ch)
+	}()
+
+	result, err = c.Import(ch, &ImportOptions{Type: "array", OnDuplicate: "update"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created != 1 || result.Updated != 1 {
+		t.Fatalf("Expected one document to be created and one updated: %+v", result)
+	}
+
+	var doc DummyFullDocument
+	if err = c.Document("a", &doc); err != nil || doc.Hi != "updated" {
+		t.Fatal("Expected the duplicate document to be updated.", err)
+	}
+}
+
+func TestImportChunks(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/_system/_api/collection" {
+			fmt.Fprint(w, `{"id":"1","name":"imports","status":3,"type":2}`)
+			return
+		}
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"error":false,"created":2,"errors":1,"empty":0,"updated":0,"ignored":1,"details":["at position 1: duplicate"]}`)
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("imports")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.reset()
+
+	docs := []map[string]int{{"a": 1}, {"a": 2}, {"a": 3}, {"a": 4}, {"a": 5}}
+	result, err := c.Import(docs, &ImportOptions{ChunkSize: 2, OnDuplicate: "ignore", Details: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the documents are sent as json lines in chunks of 2
+	chunk := "POST /import?collection=imports&complete=false&details=true&onDuplicate=ignore&type=documents&waitForSync=false "
+	rec.check(t,
+		chunk+"{\"a\":1}\n{\"a\":2}",
+		chunk+"{\"a\":3}\n{\"a\":4}",
+		chunk+"{\"a\":5}",
+	)
+
+	if result.Created != 6 || result.Errors != 3 || result.Ignored != 3 || len(result.Details) != 3 {
+		t.Fatalf("Expected the results of every chunk to be added up: %+v", result)
+	}
+
+	rec.reset()
+	_, err = c.Import(strings.NewReader(`[{"a":1},{"a":2}]`), &ImportOptions{Type: "array"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /import?collection=imports&complete=false&details=false&type=array&waitForSync=false [{"a":1},{"a":2}]`,
+	)
+
+	_, err = c.Import(42, nil)
+
+	if err == nil {
+		t.Fatal("Expected an error when importing something that isn't a list of documents.")
+	}
+}
+
+func TestImportStreams(t *testing.T) {
+
+	started := make(chan bool, 1)
+	received := make(chan []byte, 1)
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/_system/_api/collection" {
+			fmt.Fprint(w, `{"id":"1","name":"imports","status":3,"type":2}`)
+			return
+		}
+		head := make([]byte, 1024)
+		io.ReadFull(r.Body, head)
+		started <- true
+		rest, _ := io.ReadAll(r.Body)
+		received <- append(head, rest...)
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"error":false,"created":2}`)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("imports")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := make(chan DummyDocument)
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Import(ch, nil)
+		done <- err
+	}()
+
+	big := DummyDocument{Hi: strings.Repeat("x", 16*1024)}
+	ch <- big
+
+	//the server sees the first document before the channel is closed
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the documents to be streamed while the channel is still open.")
+	}
+
+	ch <- DummyDocument{Hi: "last"}
+	close(ch)
+
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	body := <-received
+
+	if !strings.HasSuffix(string(body), "\n"+`{"Hi":"last"}`) || len(body) < 16*1024 {
+		t.Fatalf("Expected both documents as json lines but got %d bytes", len(body))
+	}
+
+	if _, err = c.Import(strings.NewReader(`{"a":1}`), &ImportOptions{Type: "array"}); err == nil {
+		t.Fatal("Expected an error when the array import isn't a json array.")
+	}
+}
+
+func TestImportFailover(t *testing.T) {
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	imported := make(chan int, 2)
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/_system/_api/collection/imports" {
+			fmt.Fprint(w, `{"id":"1","name":"imports","status":3,"type":2}`)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		imported <- strings.Count(string(b), "\n") + 1
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"error":false,"created":2}`)
+	})
+	defer server.Close()
+
+	db, err := ConnWithOptions(&ConnOptions{
+		Endpoints:             []string{down.URL, server.URL},
+		EndpointRetryInterval: 50 * time.Millisecond,
+		Transport:             &http.Transport{DisableKeepAlives: true},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.Collection("imports")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//let the first endpoint be tried again
+	time.Sleep(60 * time.Millisecond)
+
+	documents := []DummyDocument{{Hi: "1"}, {Hi: "2"}}
+
+	_, err = c.Import(documents, nil)
+
+	if err == nil || strings.Contains(err.Error(), "closed pipe") {
+		t.Fatalf("Expected the import to fail with the error of the first endpoint but got %v", err)
+	}
+
+	if len(imported) != 0 {
+		t.Fatal("Expected the streamed import not to be sent to the second endpoint.")
+	}
+
+	//the first endpoint is skipped now
+	result, err := c.Import(documents, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created != 2 || <-imported != 2 {
+		t.Fatalf("Expected the documents to be imported by the second endpoint: %+v", result)
+	}
+}
@@ -0,0 +1,319 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+//Index types
+//
+//See arango manual or rest api docs for what these might mean
+const (
+	PRIMARY_INDEX  = "primary"
+	EDGE_INDEX     = "edge"
+	HASH_INDEX     = "hash"
+	SKIPLIST_INDEX = "skiplist"
+	GEO1_INDEX     = "geo1"
+	GEO2_INDEX     = "geo2"
+	FULLTEXT_INDEX = "fulltext"
+	CAP_INDEX      = "cap"
+)
+
+//Index represents an index of a collection as returned by
+//the /_api/index endpoints.
+type Index struct {
+	//Id is the index handle, like collection/12345
+	Id     string   `json:"id"`
+	Type   string   `json:"type"`
+	Fields []string `json:"fields"`
+	Unique bool     `json:"unique"`
+	Sparse bool     `json:"sparse"`
+
+	//Selectivity is only reported by indexes that estimate it.
+	Selectivity float64 `json:"selectivityEstimate"`
+
+	//Only used by geo indexes.
+	GeoJson bool `json:"geoJson"`
+
+	//Only used by fulltext indexes.
+	MinLength int `json:"minLength"`
+
+	//Only used by cap constraints.
+	Size     int `json:"size"`
+	ByteSize int `json:"byteSize"`
+
+	//IsNewlyCreated is true if the Ensure call that returned this
+	//index created it and false if it already existed.
+	IsNewlyCreated bool `json:"isNewlyCreated"`
+}
+
+//IndexOptions are the options available when ensuring
+//a hash or skiplist index.
+type IndexOptions struct {
+	//Unique rejects documents that share the values of the indexed fields.
+	Unique bool
+
+	//Sparse leaves documents missing any of the fields out of the index.
+	Sparse bool
+}
+
+//Small internal types used when creating indexes
+//and listing them.
+type createIndex struct {
+	Type      string   `json:"type"`
+	Fields    []string `json:"fields,omitempty"`
+	Unique    bool     `json:"unique,omitempty"`
+	Sparse    bool     `json:"sparse,omitempty"`
+	GeoJson   bool     `json:"geoJson,omitempty"`
+	MinLength int      `json:"minLength,omitempty"`
+	Size      int      `json:"size,omitempty"`
+	ByteSize  int      `json:"byteSize,omitempty"`
+}
+
+type indexesResult struct {
+	Indexes []*Index `json:"indexes"`
+}
+
+//Indexes returns all indexes of the collection, including
+//the primary index and, for edge collections, the edge index.
+//Uses the GET /_api/index endpoint.
+func (c *Collection) Indexes() ([]*Index, error) {
+	return c.IndexesCtx(context.Background())
+}
+
+//IndexesCtx is like Indexes but the request is bound to ctx.
+func (c *Collection) IndexesCtx(ctx context.Context) ([]*Index, error) {
+
+	db := c.db
+
+	var query url.Values = make(url.Values)
+	query.Add("collection", c.Name())
+
+	var result indexesResult
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/index", db.serverUrl.String())
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, &query, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return result.Indexes, nil
+	default:
+		return nil, e
+	}
+}
+
+//Index fetches a single index of the collection.
+//id can be the full index handle, like collection/12345,
+//or just the part after the slash. Handles of other collections
+//are rejected.
+func (c *Collection) Index(id string) (*Index, error) {
+	return c.IndexCtx(context.Background(), id)
+}
+
+//IndexCtx is like Index but the request is bound to ctx.
+func (c *Collection) IndexCtx(ctx context.Context, id string) (*Index, error) {
+
+	if id == "" {
+		return nil, newError("You must specify an index id when fetching an index.")
+	}
+
+	handle, ok := c.indexHandle(id)
+	if !ok {
+		return nil, newError("Cross collection requests are not permitted.")
+	}
+
+	db := c.db
+
+	var index = new(Index)
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/index/%s", db.serverUrl.String(), handle)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, nil, index, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return index, nil
+	default:
+		return nil, e
+	}
+}
+
+//DropIndex deletes an index of the collection.
+//id can be the full index handle or just the part after the slash.
+//Handles of other collections are rejected.
+//The primary and edge indexes can't be dropped.
+func (c *Collection) DropIndex(id string) error {
+	return c.DropIndexCtx(context.Background(), id)
+}
+
+//DropIndexCtx is like DropIndex but the request is bound to ctx.
+func (c *Collection) DropIndexCtx(ctx context.Context, id string) error {
+
+	if id == "" {
+		return newError("You must specify an index id when dropping an index.")
+	}
+
+	handle, ok := c.indexHandle(id)
+	if !ok {
+		return newError("Cross collection requests are not permitted.")
+	}
+
+	db := c.db
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/index/%s", db.serverUrl.String(), handle)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, &struct{}{}, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
+
+//EnsureHashIndex creates a hash index on fields unless an identical
+//one exists already. options can be nil.
+func (c *Collection) EnsureHashIndex(fields []string, options *IndexOptions) (*Index, error) {
+	return c.EnsureHashIndexCtx(context.Background(), fields, options)
+}
+
+//EnsureHashIndexCtx is like EnsureHashIndex but the request is bound to ctx.
+func (c *Collection) EnsureHashIndexCtx(ctx context.Context, fields []string, options *IndexOptions) (*Index, error) {
+	var index = createIndex{Type: HASH_INDEX, Fields: fields}
+	if options != nil {
+		index.Unique = options.Unique
+		index.Sparse = options.Sparse
+	}
+	return c.ensureIndex(ctx, &index)
+}
+
+//EnsureSkiplistIndex creates a skiplist index on fields unless an identical
+//one exists already. Skiplists support range queries and sorting.
+//options can be nil.
+func (c *Collection) EnsureSkiplistIndex(fields []string, options *IndexOptions) (*Index, error) {
+	return c.EnsureSkiplistIndexCtx(context.Background(), fields, options)
+}
+
+//EnsureSkiplistIndexCtx is like EnsureSkiplistIndex but the request is bound to ctx.
+func (c *Collection) EnsureSkiplistIndexCtx(ctx context.Context, fields []string, options *IndexOptions) (*Index, error) {
+	var index = createIndex{Type: SKIPLIST_INDEX, Fields: fields}
+	if options != nil {
+		index.Unique = options.Unique
+		index.Sparse = options.Sparse
+	}
+	return c.ensureIndex(ctx, &index)
+}
+
+//EnsureGeoIndex creates a geo index unless an identical one exists already.
+//Pass one field holding a [lat, lon] pair, or [lon, lat] if geoJson is true,
+//or two fields holding the latitude and longitude.
+func (c *Collection) EnsureGeoIndex(fields []string, geoJson bool) (*Index, error) {
+	return c.EnsureGeoIndexCtx(context.Background(), fields, geoJson)
+}
+
+//EnsureGeoIndexCtx is like EnsureGeoIndex but the request is bound to ctx.
+func (c *Collection) EnsureGeoIndexCtx(ctx context.Context, fields []string, geoJson bool) (*Index, error) {
+	return c.ensureIndex(ctx, &createIndex{Type: "geo", Fields: fields, GeoJson: geoJson})
+}
+
+//EnsureFulltextIndex creates a fulltext index on field unless an identical
+//one exists already. Words shorter than minLength aren't indexed.
+//If minLength is 0 arango uses its default.
+func (c *Collection) EnsureFulltextIndex(field string, minLength int) (*Index, error) {
+	return c.EnsureFulltextIndexCtx(context.Background(), field, minLength)
+}
+
+//EnsureFulltextIndexCtx is like EnsureFulltextIndex but the request is bound to ctx.
+func (c *Collection) EnsureFulltextIndexCtx(ctx context.Context, field string, minLength int) (*Index, error) {
+	return c.ensureIndex(ctx, &createIndex{Type: FULLTEXT_INDEX, Fields: []string{field}, MinLength: minLength})
+}
+
+//EnsureCapConstraint limits the collection to size documents or byteSize
+//bytes, removing the oldest documents first. Either can be 0 but not both.
+func (c *Collection) EnsureCapConstraint(size, byteSize int) (*Index, error) {
+	return c.EnsureCapConstraintCtx(context.Background(), size, byteSize)
+}
+
+//EnsureCapConstraintCtx is like EnsureCapConstraint but the request is bound to ctx.
+func (c *Collection) EnsureCapConstraintCtx(ctx context.Context, size, byteSize int) (*Index, error) {
+
+	if size <= 0 && byteSize <= 0 {
+		return nil, newError("You must specify a size or byteSize when creating a cap constraint.")
+	}
+
+	return c.ensureIndex(ctx, &createIndex{Type: CAP_INDEX, Size: size, ByteSize: byteSize})
+}
+
+//ensureIndex sends index to the POST /_api/index endpoint.
+//Arango answers with 201 when it created the index and
+//with 200 when an identical one existed already.
+func (c *Collection) ensureIndex(ctx context.Context, index *createIndex) (*Index, error) {
+
+	if index.Type != CAP_INDEX && len(index.Fields) == 0 {
+		return nil, newError("You must specify at least one field when creating an index.")
+	}
+
+	for _, field := range index.Fields {
+		if field == "" {
+			return nil, newError("Index fields can't be empty.")
+		}
+	}
+
+	db := c.db
+
+	var query url.Values = make(url.Values)
+	query.Add("collection", c.Name())
+
+	var result = new(Index)
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/index?%s", db.serverUrl.String(), query.Encode())
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, index, result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200, 201:
+		return result, nil
+	default:
+		return nil, e
+	}
+}
+
+//indexHandle prefixes id with the collection name unless it is
+//a full index handle already. Like crossCollectionCheck it reports
+//false for a handle of another collection.
+func (c *Collection) indexHandle(id string) (string, bool) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) == 1 {
+		return c.Name() + "/" + id, true
+	}
+	return id, len(idParts) == 2 && idParts[0] == c.Name()
+}
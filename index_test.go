@@ -0,0 +1,175 @@
+package arango
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestIndexes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("indexed")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := c.EnsureHashIndex([]string{"Hi"}, &IndexOptions{Unique: true, Sparse: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash.Type != HASH_INDEX || !hash.Unique || !hash.Sparse || !hash.IsNewlyCreated {
+		t.Fatalf("Unexpected hash index %+v", hash)
+	}
+
+	again, err := c.EnsureHashIndex([]string{"Hi"}, &IndexOptions{Unique: true, Sparse: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again.Id != hash.Id || again.IsNewlyCreated {
+		t.Fatalf("Expected the existing index to be returned: %+v", again)
+	}
+
+	if _, err = c.EnsureSkiplistIndex([]string{"a", "b"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.EnsureGeoIndex([]string{"loc"}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.EnsureFulltextIndex("text", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.EnsureCapConstraint(100, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	indexes, err := c.Indexes()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(indexes) != 6 || indexes[0].Type != PRIMARY_INDEX {
+		t.Fatalf("Expected the primary index and 5 more: %+v", indexes)
+	}
+
+	index, err := c.Index(hash.Id)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if index.Id != hash.Id || index.Fields[0] != "Hi" {
+		t.Fatalf("Unexpected index %+v", index)
+	}
+
+	if err = c.DropIndex(hash.Id); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.Index(hash.Id); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error after dropping the index but got %v", err)
+	}
+}
+
+func TestIndexRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		switch {
+		case r.URL.Path == "/_db/_system/_api/collection":
+			fmt.Fprint(w, `{"id":"1","name":"indexed","status":3,"type":2}`)
+		case r.Method == "POST":
+			var index map[string]interface{}
+			json.Unmarshal(b, &index)
+			w.WriteHeader(201)
+			fmt.Fprintf(w, `{"id":"indexed/2","type":%q,"fields":["Hi"],"unique":true,"isNewlyCreated":true,"error":false,"code":201}`, index["type"])
+		case r.Method == "DELETE":
+			fmt.Fprint(w, `{"id":"indexed/2","error":false,"code":200}`)
+		default:
+			fmt.Fprint(w, `{"id":"indexed/2","type":"hash","fields":["Hi"],"unique":true,"selectivityEstimate":1,"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("indexed")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.EnsureHashIndex(nil, nil); err == nil {
+		t.Fatal("Expected an error when ensuring an index without fields.")
+	}
+
+	if _, err = c.EnsureCapConstraint(0, 0); err == nil {
+		t.Fatal("Expected an error when ensuring a cap constraint without a size.")
+	}
+
+	rec.reset()
+
+	if _, err = c.EnsureFulltextIndex("", 3); err == nil {
+		t.Fatal("Expected an error when ensuring a fulltext index without a field.")
+	}
+
+	if _, err = c.Index("other/2"); err == nil {
+		t.Fatal("Expected an error when fetching an index of another collection.")
+	}
+
+	if err = c.DropIndex("other/2"); err == nil {
+		t.Fatal("Expected an error when dropping an index of another collection.")
+	}
+
+	index, err := c.EnsureHashIndex([]string{"Hi"}, &IndexOptions{Unique: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if index.Id != "indexed/2" || !index.Unique || !index.IsNewlyCreated {
+		t.Fatalf("Unexpected index %+v", index)
+	}
+
+	if _, err = c.EnsureCapConstraint(10, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err = c.Index("2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if index.Selectivity != 1 {
+		t.Fatalf("Expected the selectivity estimate to be decoded: %+v", index)
+	}
+
+	if err = c.DropIndex("indexed/2"); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /index?collection=indexed {"type":"hash","fields":["Hi"],"unique":true}`,
+		`POST /index?collection=indexed {"type":"cap","size":10}`,
+		`GET /index/indexed/2`,
+		`DELETE /index/indexed/2`,
+	)
+}
@@ -0,0 +1,109 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+)
+
+//AqlQuery represents an AQL query that is sent to the
+//POST /_api/cursor endpoint.
+//
+//Bind parameters are passed in BindVars. Use the name without
+//the leading @ as the key. For example, a query containing
+//@name needs BindVars["name"] and a query containing the collection
+//bind parameter @@coll needs BindVars["@coll"].
+type AqlQuery struct {
+	Query    string                 `json:"query"`
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+
+	//Count tells arango to return the total number of results.
+	//Cursor.Count() will only be accurate if this is true.
+	Count bool `json:"count,omitempty"`
+
+	//BatchSize is the number of results transferred from the
+	//server per round trip. If it is 0 then it is ignored and
+	//the arango server picks a value.
+	BatchSize int `json:"batchSize,omitempty"`
+
+	//Ttl is the number of seconds the server keeps the cursor
+	//around after the last access. If 0 then it is ignored.
+	Ttl int `json:"ttl,omitempty"`
+
+	//FullCount makes arango calculate the number of results
+	//the query would have produced without the final LIMIT.
+	//Retrieve it with Cursor.FullCount().
+	FullCount bool `json:"-"`
+}
+
+//NewAqlQuery is a shortcut for creating an AqlQuery with
+//an empty set of bind variables.
+func NewAqlQuery(query string) *AqlQuery {
+	return &AqlQuery{
+		Query:    query,
+		BindVars: make(map[string]interface{}),
+	}
+}
+
+//Bind sets the bind variable name to value and returns the query
+//so calls can be chained. Pass the name without the leading @.
+//For collection bind parameters pass "@coll" for @@coll.
+func (q *AqlQuery) Bind(name string, value interface{}) *AqlQuery {
+	if q.BindVars == nil {
+		q.BindVars = make(map[string]interface{})
+	}
+	q.BindVars[name] = value
+	return q
+}
+
+//Small internal type used when sending the query
+//so the options are nested the way arango wants them.
+type createCursor struct {
+	*AqlQuery
+	Options *createCursorOptions `json:"options,omitempty"`
+}
+
+type createCursorOptions struct {
+	FullCount bool `json:"fullCount,omitempty"`
+}
+
+//Query runs an AQL query using the POST /_api/cursor endpoint
+//and returns a Cursor to iterate over the results.
+func (db *Database) Query(query *AqlQuery) (*Cursor, error) {
+	return db.QueryCtx(context.Background(), query)
+}
+
+//QueryCtx is like Query but the request is bound to ctx.
+func (db *Database) QueryCtx(ctx context.Context, query *AqlQuery) (*Cursor, error) {
+
+	if query == nil || query.Query == "" {
+		return nil, newError("You must provide a query string when calling Query.")
+	}
+
+	var payload = createCursor{AqlQuery: query}
+
+	if query.FullCount {
+		payload.Options = &createCursorOptions{FullCount: true}
+	}
+
+	var c = new(Cursor)
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/cursor",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, &payload, &c.json, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 201:
+		c.db = db
+		return c, nil
+	default:
+		return nil, e
+	}
+}
@@ -0,0 +1,212 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+//RunningQuery is an AQL query that is currently running or,
+//for SlowQueries, one that took longer than the slow query
+//threshold.
+type RunningQuery struct {
+	Id       string                 `json:"id"`
+	Query    string                 `json:"query"`
+	BindVars map[string]interface{} `json:"bindVars"`
+	Started  time.Time              `json:"started"`
+
+	//RunTime is the number of seconds the query has been
+	//running or, for slow queries, took to finish.
+	RunTime float64 `json:"runTime"`
+	State   string  `json:"state"`
+	Stream  bool    `json:"stream"`
+}
+
+//QueryTrackingProperties configure how arango keeps track of
+//running and slow queries.
+type QueryTrackingProperties struct {
+	Enabled          bool `json:"enabled"`
+	TrackSlowQueries bool `json:"trackSlowQueries"`
+	TrackBindVars    bool `json:"trackBindVars"`
+	MaxSlowQueries   int  `json:"maxSlowQueries"`
+
+	//SlowQueryThreshold is the number of seconds after which
+	//a query counts as slow.
+	SlowQueryThreshold   float64 `json:"slowQueryThreshold"`
+	MaxQueryStringLength int     `json:"maxQueryStringLength"`
+}
+
+//RunningQueries returns the AQL queries currently running
+//in the database.
+func (db *Database) RunningQueries() ([]RunningQuery, error) {
+	return db.RunningQueriesCtx(context.Background())
+}
+
+//RunningQueriesCtx is like RunningQueries but the request is bound to ctx.
+func (db *Database) RunningQueriesCtx(ctx context.Context) ([]RunningQuery, error) {
+	return db.trackedQueries(ctx, "current")
+}
+
+//SlowQueries returns the most recent queries that took longer
+//than the slow query threshold.
+func (db *Database) SlowQueries() ([]RunningQuery, error) {
+	return db.SlowQueriesCtx(context.Background())
+}
+
+//SlowQueriesCtx is like SlowQueries but the request is bound to ctx.
+func (db *Database) SlowQueriesCtx(ctx context.Context) ([]RunningQuery, error) {
+	return db.trackedQueries(ctx, "slow")
+}
+
+//ClearSlowQueries empties the list of slow queries.
+func (db *Database) ClearSlowQueries() error {
+	return db.ClearSlowQueriesCtx(context.Background())
+}
+
+//ClearSlowQueriesCtx is like ClearSlowQueries but the request is bound to ctx.
+func (db *Database) ClearSlowQueriesCtx(ctx context.Context) error {
+	return db.deleteQuery(ctx, "slow")
+}
+
+//KillQuery stops the running query with the given id.
+func (db *Database) KillQuery(id string) error {
+	return db.KillQueryCtx(context.Background(), id)
+}
+
+//KillQueryCtx is like KillQuery but the request is bound to ctx.
+func (db *Database) KillQueryCtx(ctx context.Context, id string) error {
+
+	if id == "" {
+		return newError("You must provide the id of the query to kill.")
+	}
+
+	return db.deleteQuery(ctx, url.PathEscape(id))
+}
+
+//QueryTrackingProperties returns how arango currently keeps
+//track of queries.
+func (db *Database) QueryTrackingProperties() (*QueryTrackingProperties, error) {
+	return db.QueryTrackingPropertiesCtx(context.Background())
+}
+
+//QueryTrackingPropertiesCtx is like QueryTrackingProperties but the request is bound to ctx.
+func (db *Database) QueryTrackingPropertiesCtx(ctx context.Context) (*QueryTrackingProperties, error) {
+	var properties QueryTrackingProperties
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/query/properties",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, nil, &properties, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return &properties, nil
+	default:
+		return nil, e
+	}
+}
+
+//QueryTrackingUpdate holds the query tracking properties to
+//change with SetQueryTrackingProperties. Nil fields are left as
+//they are on the server, so only set what should change:
+//
+//  threshold := 0.5
+//  db.SetQueryTrackingProperties(&arango.QueryTrackingUpdate{SlowQueryThreshold: &threshold})
+type QueryTrackingUpdate struct {
+	Enabled              *bool    `json:"enabled,omitempty"`
+	TrackSlowQueries     *bool    `json:"trackSlowQueries,omitempty"`
+	TrackBindVars        *bool    `json:"trackBindVars,omitempty"`
+	MaxSlowQueries       *int     `json:"maxSlowQueries,omitempty"`
+	SlowQueryThreshold   *float64 `json:"slowQueryThreshold,omitempty"`
+	MaxQueryStringLength *int     `json:"maxQueryStringLength,omitempty"`
+}
+
+//SetQueryTrackingProperties changes how arango keeps track of
+//queries and returns the properties now in effect.
+func (db *Database) SetQueryTrackingProperties(update *QueryTrackingUpdate) (*QueryTrackingProperties, error) {
+	return db.SetQueryTrackingPropertiesCtx(context.Background(), update)
+}
+
+//SetQueryTrackingPropertiesCtx is like SetQueryTrackingProperties but the request is bound to ctx.
+func (db *Database) SetQueryTrackingPropertiesCtx(ctx context.Context, update *QueryTrackingUpdate) (*QueryTrackingProperties, error) {
+
+	if update == nil {
+		return nil, newError("You must provide the query tracking properties to set.")
+	}
+
+	var result QueryTrackingProperties
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/query/properties",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, update, &result, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return &result, nil
+	default:
+		return nil, e
+	}
+}
+
+func (db *Database) trackedQueries(ctx context.Context, list string) ([]RunningQuery, error) {
+	var queries []RunningQuery
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/query/%s",
+		db.serverUrl.String(),
+		list,
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Get(endpoint, nil, &queries, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return queries, nil
+	default:
+		return nil, e
+	}
+}
+
+func (db *Database) deleteQuery(ctx context.Context, path string) error {
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/query/%s",
+		db.serverUrl.String(),
+		path,
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Delete(endpoint, nil, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
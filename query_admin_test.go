@@ -0,0 +1,147 @@
+package arango
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestQueryTracking(t *testing.T) {
+	setup()
+	defer teardown()
+
+	properties, err := db.QueryTrackingProperties()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := properties.Enabled
+	track, threshold := true, 0.1
+
+	properties, err = db.SetQueryTrackingProperties(&QueryTrackingUpdate{
+		TrackSlowQueries:   &track,
+		SlowQueryThreshold: &threshold,
+	})
+
+	if err != nil || properties.SlowQueryThreshold != 0.1 || properties.Enabled != enabled {
+		t.Fatalf("Expected only the slow query settings to be changed: %+v %v", properties, err)
+	}
+
+	if _, err = db.Query(NewAqlQuery("RETURN SLEEP(0.2)")); err != nil {
+		t.Fatal(err)
+	}
+
+	slow, err := db.SlowQueries()
+
+	if err != nil || len(slow) == 0 || slow[0].Query != "RETURN SLEEP(0.2)" {
+		t.Fatalf("Expected the sleeping query to be slow: %+v %v", slow, err)
+	}
+
+	if err = db.ClearSlowQueries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if slow, err = db.SlowQueries(); err != nil || len(slow) != 0 {
+		t.Fatalf("Expected the slow queries to be cleared: %+v %v", slow, err)
+	}
+
+	if _, err = db.RunningQueries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.KillQuery("1"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error when killing an unknown query but got %v", err)
+	}
+}
+
+func TestQueryTrackingRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/properties"):
+			fmt.Fprint(w, `{"enabled":true,"trackSlowQueries":true,"trackBindVars":true,"maxSlowQueries":64,"slowQueryThreshold":10,"maxQueryStringLength":4096}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `[{"id":"17","query":"FOR d IN docs RETURN d","bindVars":{"x":1},"started":"2016-09-21T10:19:44Z","runTime":1.5,"state":"executing","stream":false}]`)
+		case strings.HasSuffix(r.URL.Path, "/404"):
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"error":true,"code":404,"errorNum":1591,"errorMessage":"query not found"}`)
+		default:
+			fmt.Fprint(w, `{"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.reset()
+
+	running, err := db.RunningQueries()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(running) != 1 || running[0].Id != "17" || running[0].RunTime != 1.5 || running[0].Started.Year() != 2016 {
+		t.Fatalf("Expected the running query to be decoded: %+v", running)
+	}
+
+	if _, err = db.SlowQueries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.ClearSlowQueries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.KillQuery(""); err == nil {
+		t.Fatal("Expected an error when killing a query without an id.")
+	}
+
+	if err = db.KillQuery("17"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.KillQuery("404"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error but got %v", err)
+	}
+
+	properties, err := db.QueryTrackingProperties()
+
+	if err != nil || properties.MaxSlowQueries != 64 || properties.SlowQueryThreshold != 10 {
+		t.Fatalf("Expected the properties to be decoded: %+v %v", properties, err)
+	}
+
+	if _, err = db.SetQueryTrackingProperties(nil); err == nil {
+		t.Fatal("Expected an error when setting nil properties.")
+	}
+
+	threshold := 2.5
+
+	if _, err = db.SetQueryTrackingProperties(&QueryTrackingUpdate{SlowQueryThreshold: &threshold}); err != nil {
+		t.Fatal(err)
+	}
+
+	disabled, none := false, 0
+
+	if _, err = db.SetQueryTrackingProperties(&QueryTrackingUpdate{Enabled: &disabled, MaxSlowQueries: &none}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`GET /query/current`,
+		`GET /query/slow`,
+		`DELETE /query/slow`,
+		`DELETE /query/17`,
+		`DELETE /query/404`,
+		`GET /query/properties`,
+		`PUT /query/properties {"slowQueryThreshold":2.5}`,
+		`PUT /query/properties {"enabled":false,"maxSlowQueries":0}`,
+	)
+}
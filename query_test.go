@@ -0,0 +1,218 @@
+package arango
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAqlQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	db := db
+
+	type basic struct {
+		DocumentImplementation
+		Field string `json:"field"`
+		Num   int    `json:"num"`
+	}
+
+	c, err := db.CreateDocumentCollection("aql_docs")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		err = c.Save(&basic{Field: "hi", Num: i})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.Save(&basic{Field: "bye", Num: 10})
+
+	_, err = db.Query(nil)
+
+	if err == nil {
+		t.Fatal("Expected an error when running a nil query.")
+	}
+
+	_, err = db.Query(NewAqlQuery("FOR d IN @@coll RETURN"))
+
+	if err == nil {
+		t.Fatal("Expected an error when running an invalid query.")
+	}
+
+	q := NewAqlQuery("FOR d IN @@coll FILTER d.field == @field SORT d.num LIMIT 2 RETURN d").
+		Bind("@coll", c.Name()).
+		Bind("field", "hi")
+	q.Count = true
+	q.FullCount = true
+	q.BatchSize = 1
+
+	cur, err := db.Query(q)
+
+	if err != nil {
+		t.Fatal("Did not expect an error when running a query.", err)
+	}
+
+	if cur.Count() != 2 {
+		t.Fatalf("Expected a count of 2 but got %d", cur.Count())
+	}
+
+	if cur.FullCount() != 5 {
+		t.Fatalf("Expected a full count of 5 but got %d", cur.FullCount())
+	}
+
+	i := 0
+	for cur.HasMore() {
+		var doc basic
+		err = cur.Next(&doc)
+		if err != nil {
+			t.Fatal("Error while fetching the next document", err)
+		}
+		if doc.Field != "hi" || doc.Num != i {
+			t.Fatalf("Fetched a document we did not expect: %+v", doc)
+		}
+		i++
+		if i > 2 {
+			t.Fatal("Got stuck in a loop but should've only gotten two documents.")
+		}
+	}
+
+	if i != 2 {
+		t.Fatalf("Expected 2 documents but got %d", i)
+	}
+}
+
+func TestExplainAndParseQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("explain_docs")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := NewAqlQuery("FOR d IN @@coll FILTER d.num == @num RETURN d").
+		Bind("@coll", c.Name()).
+		Bind("num", 1)
+
+	explained, err := db.ExplainQuery(q, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if explained.Plan == nil || len(explained.Plan.CollectionScans()) != 1 {
+		t.Fatalf("Expected a full scan of the collection: %+v", explained.Plan)
+	}
+
+	explained, err = db.ExplainQuery(q, &ExplainOptions{AllPlans: true, MaxNumberOfPlans: 2})
+
+	if err != nil || len(explained.Plans) == 0 {
+		t.Fatalf("Expected every plan to be returned: %+v %v", explained, err)
+	}
+
+	parsed, err := db.ParseQuery(q.Query)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(parsed.BindVars) != 2 || len(parsed.Ast) == 0 {
+		t.Fatalf("Expected the bind parameters and ast of the query: %+v", parsed)
+	}
+
+	var parseError QueryParseError
+
+	if err = db.ValidateQuery("FOR d IN docs\nRETURN"); !errors.As(err, &parseError) || parseError.Line != 2 {
+		t.Fatalf("Expected a parse error on line 2 but got %v", err)
+	}
+
+	if !errors.Is(err, ErrQueryParse) {
+		t.Fatalf("Expected the parse error to match ErrQueryParse: %v", err)
+	}
+}
+
+func TestExplainQueryRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/explain"):
+			fmt.Fprint(w, `{"plan":{"nodes":[{"type":"SingletonNode","id":1,"dependencies":[]},`+
+				`{"type":"EnumerateCollectionNode","id":2,"dependencies":[1],"collection":"docs","estimatedCost":12.5},`+
+				`{"type":"IndexNode","id":3,"dependencies":[2],"collection":"users","indexes":[{"id":"users/0","type":"primary","fields":["_key"]}]}],`+
+				`"rules":["use-indexes"],"collections":[{"name":"docs","type":"read"}],"estimatedCost":14},"cacheable":true,"warnings":[],"error":false,"code":200}`)
+		case strings.Contains(string(b), "RETURN d"):
+			fmt.Fprint(w, `{"parsed":true,"collections":["docs"],"bindVars":["@coll"],"ast":[{"type":"root"}],"error":false,"code":200}`)
+		default:
+			w.WriteHeader(400)
+			fmt.Fprint(w, `{"error":true,"code":400,"errorNum":1501,"errorMessage":"syntax error, unexpected end of query string near 'RETURN' at position 2:1"}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec.reset()
+
+	if _, err = db.ExplainQuery(nil, nil); err == nil {
+		t.Fatal("Expected an error when explaining a nil query.")
+	}
+
+	explained, err := db.ExplainQuery(NewAqlQuery("FOR d IN docs RETURN d").Bind("num", 1), &ExplainOptions{AllPlans: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := explained.Plan
+
+	if plan.EstimatedCost != 14 || plan.Rules[0] != "use-indexes" || !explained.Cacheable {
+		t.Fatalf("Expected the plan to be decoded: %+v", explained)
+	}
+
+	if scans := plan.CollectionScans(); len(scans) != 1 || scans[0] != "docs" {
+		t.Fatalf("Expected docs to be scanned in full: %v", scans)
+	}
+
+	if indexed := plan.NodesOfType(NODE_INDEX); len(indexed) != 1 || indexed[0].Indexes[0].Type != PRIMARY_INDEX {
+		t.Fatalf("Expected the index node to be decoded: %+v", indexed)
+	}
+
+	parsed, err := db.ParseQuery("FOR d IN @@coll RETURN d")
+
+	if err != nil || parsed.Collections[0] != "docs" || parsed.BindVars[0] != "@coll" {
+		t.Fatalf("Expected the parsed query to be decoded: %+v %v", parsed, err)
+	}
+
+	err = db.ValidateQuery("FOR d IN docs\nRETURN")
+
+	var parseError QueryParseError
+
+	if !errors.As(err, &parseError) || parseError.Line != 2 || parseError.Column != 1 {
+		t.Fatalf("Expected the position of the syntax error but got %v", err)
+	}
+
+	if !errors.Is(err, ErrQueryParse) || !errors.As(err, new(ArangoError)) {
+		t.Fatalf("Expected the parse error to still be an ArangoError: %v", err)
+	}
+
+	rec.check(t,
+		`POST /explain {"query":"FOR d IN docs RETURN d","bindVars":{"num":1},"options":{"allPlans":true}}`,
+		`POST /query {"query":"FOR d IN @@coll RETURN d"}`,
+		`POST /query {"query":"FOR d IN docs\nRETURN"}`,
+	)
+}
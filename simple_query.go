@@ -1,6 +1,7 @@
 package arango
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -18,6 +19,11 @@ type FirstExampleQuery struct {
 }
 
 func (db *Database) ByExampleQuery(query *ByExampleQuery) (*Cursor, error) {
+	return db.ByExampleQueryCtx(context.Background(), query)
+}
+
+//ByExampleQueryCtx is like ByExampleQuery but the request is bound to ctx.
+func (db *Database) ByExampleQueryCtx(ctx context.Context, query *ByExampleQuery) (*Cursor, error) {
 
 	var c = new(Cursor)
 	var e ArangoError
@@ -26,10 +32,11 @@ func (db *Database) ByExampleQuery(query *ByExampleQuery) (*Cursor, error) {
 		db.serverUrl.String(),
 	)
 
-	response, err := db.session.Put(endpoint, query, &c.json, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, query, &c.json, &e)
 
 	if err != nil {
-		return nil, newError(err.Error())
+		return nil, wrapError(err)
 	}
 
 	switch response.Status() {
@@ -51,6 +58,11 @@ type firstExampleResult struct{
 //FirstExample will call the PUT /_api/simple/first-example endpoint.
 //The value pointed to by document is populated with the result from Arango.
 func (db *Database) FirstExample(query *FirstExampleQuery, document interface{}) error {
+	return db.FirstExampleCtx(context.Background(), query, document)
+}
+
+//FirstExampleCtx is like FirstExample but the request is bound to ctx.
+func (db *Database) FirstExampleCtx(ctx context.Context, query *FirstExampleQuery, document interface{}) error {
 	var e ArangoError
 	endpoint := fmt.Sprintf("%s/simple/first-example",
 		db.serverUrl.String(),
@@ -60,10 +72,11 @@ func (db *Database) FirstExample(query *FirstExampleQuery, document interface{})
         Document : document,
     }
 
-	response, err := db.session.Put(endpoint, query, result, &e)
+	session := db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, query, result, &e)
 
 	if err != nil {
-		return newError(err.Error())
+		return wrapError(err)
 	}
 
 	switch response.Status() {
@@ -73,3 +86,420 @@ func (db *Database) FirstExample(query *FirstExampleQuery, document interface{})
 		return e
 	}
 }
+
+//AllQuery is used by the PUT /_api/simple/all endpoint.
+type AllQuery struct {
+	Collection string `json:"collection"`
+	Skip       int    `json:"skip,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	BatchSize  int    `json:"batchSize,omitempty"`
+}
+
+//AnyQuery is used by the PUT /_api/simple/any endpoint.
+type AnyQuery struct {
+	Collection string `json:"collection"`
+}
+
+//RangeQuery is used by the PUT /_api/simple/range endpoint.
+//Attribute needs a skiplist index. Left is inclusive and
+//Right is exclusive unless Closed is true.
+type RangeQuery struct {
+	Collection string      `json:"collection"`
+	Attribute  string      `json:"attribute"`
+	Left       interface{} `json:"left"`
+	Right      interface{} `json:"right"`
+	Closed     bool        `json:"closed,omitempty"`
+	Skip       int         `json:"skip,omitempty"`
+	Limit      int         `json:"limit,omitempty"`
+	BatchSize  int         `json:"batchSize,omitempty"`
+}
+
+//NearQuery is used by the PUT /_api/simple/near endpoint.
+//The collection needs a geo index.
+type NearQuery struct {
+	Collection string  `json:"collection"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+
+	//Distance is the name of an attribute arango adds to every
+	//result holding its distance in meters. Left out if blank.
+	Distance string `json:"distance,omitempty"`
+
+	//Geo is the id of the geo index to use when the
+	//collection has more than one.
+	Geo string `json:"geo,omitempty"`
+
+	Skip      int `json:"skip,omitempty"`
+	Limit     int `json:"limit,omitempty"`
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+//WithinQuery is used by the PUT /_api/simple/within endpoint.
+//Radius is in meters. The collection needs a geo index.
+type WithinQuery struct {
+	Collection string  `json:"collection"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Radius     float64 `json:"radius"`
+
+	//Distance is the name of an attribute arango adds to every
+	//result holding its distance in meters. Left out if blank.
+	Distance string `json:"distance,omitempty"`
+
+	//Geo is the id of the geo index to use when the
+	//collection has more than one.
+	Geo string `json:"geo,omitempty"`
+
+	Skip      int `json:"skip,omitempty"`
+	Limit     int `json:"limit,omitempty"`
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+//FulltextQuery is used by the PUT /_api/simple/fulltext endpoint.
+//Attribute needs a fulltext index. See the arango manual for
+//the syntax of Query, like "prefix:hel,world".
+type FulltextQuery struct {
+	Collection string `json:"collection"`
+	Attribute  string `json:"attribute"`
+	Query      string `json:"query"`
+
+	//Index is the id of the fulltext index to use.
+	Index string `json:"index,omitempty"`
+
+	Skip      int `json:"skip,omitempty"`
+	Limit     int `json:"limit,omitempty"`
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+//FirstLastQuery is used by the PUT /_api/simple/first and
+//PUT /_api/simple/last endpoints. Leave Count at 0 to get a single
+//document. Otherwise up to Count documents are returned as a list.
+type FirstLastQuery struct {
+	Collection string `json:"collection"`
+	Count      int    `json:"count,omitempty"`
+}
+
+//LookupByKeysQuery is used by the PUT /_api/simple/lookup-by-keys endpoint.
+type LookupByKeysQuery struct {
+	Collection string   `json:"collection"`
+	Keys       []string `json:"keys"`
+}
+
+//All will call the PUT /_api/simple/all endpoint and
+//return a cursor over every document in the collection.
+func (db *Database) All(query *AllQuery) (*Cursor, error) {
+	return db.AllCtx(context.Background(), query)
+}
+
+//AllCtx is like All but the request is bound to ctx.
+func (db *Database) AllCtx(ctx context.Context, query *AllQuery) (*Cursor, error) {
+	return db.simpleCursor(ctx, "all", query)
+}
+
+//Any will call the PUT /_api/simple/any endpoint.
+//The value pointed to by document is populated with a random
+//document from the collection. It is left alone if the collection is empty.
+func (db *Database) Any(query *AnyQuery, document interface{}) error {
+	return db.AnyCtx(context.Background(), query, document)
+}
+
+//AnyCtx is like Any but the request is bound to ctx.
+func (db *Database) AnyCtx(ctx context.Context, query *AnyQuery, document interface{}) error {
+	return db.simpleDocument(ctx, "any", query, &firstExampleResult{Document: document})
+}
+
+//Range will call the PUT /_api/simple/range endpoint.
+func (db *Database) Range(query *RangeQuery) (*Cursor, error) {
+	return db.RangeCtx(context.Background(), query)
+}
+
+//RangeCtx is like Range but the request is bound to ctx.
+func (db *Database) RangeCtx(ctx context.Context, query *RangeQuery) (*Cursor, error) {
+	return db.simpleCursor(ctx, "range", query)
+}
+
+//Near will call the PUT /_api/simple/near endpoint and return
+//a cursor over the documents closest to the given coordinate.
+func (db *Database) Near(query *NearQuery) (*Cursor, error) {
+	return db.NearCtx(context.Background(), query)
+}
+
+//NearCtx is like Near but the request is bound to ctx.
+func (db *Database) NearCtx(ctx context.Context, query *NearQuery) (*Cursor, error) {
+	return db.simpleCursor(ctx, "near", query)
+}
+
+//Within will call the PUT /_api/simple/within endpoint and return
+//a cursor over the documents within a radius of the given coordinate.
+func (db *Database) Within(query *WithinQuery) (*Cursor, error) {
+	return db.WithinCtx(context.Background(), query)
+}
+
+//WithinCtx is like Within but the request is bound to ctx.
+func (db *Database) WithinCtx(ctx context.Context, query *WithinQuery) (*Cursor, error) {
+	return db.simpleCursor(ctx, "within", query)
+}
+
+//Fulltext will call the PUT /_api/simple/fulltext endpoint.
+func (db *Database) Fulltext(query *FulltextQuery) (*Cursor, error) {
+	return db.FulltextCtx(context.Background(), query)
+}
+
+//FulltextCtx is like Fulltext but the request is bound to ctx.
+func (db *Database) FulltextCtx(ctx context.Context, query *FulltextQuery) (*Cursor, error) {
+	return db.simpleCursor(ctx, "fulltext", query)
+}
+
+type firstLastResult struct {
+	Result interface{} `json:"result"`
+}
+
+//First will call the PUT /_api/simple/first endpoint to fetch the
+//oldest documents in the collection. If query.Count is 0 then result
+//should point to a single document. Otherwise it should point to a slice.
+func (db *Database) First(query *FirstLastQuery, result interface{}) error {
+	return db.FirstCtx(context.Background(), query, result)
+}
+
+//FirstCtx is like First but the request is bound to ctx.
+func (db *Database) FirstCtx(ctx context.Context, query *FirstLastQuery, result interface{}) error {
+	return db.simpleDocument(ctx, "first", query, &firstLastResult{Result: result})
+}
+
+//Last will call the PUT /_api/simple/last endpoint to fetch the
+//newest documents in the collection. If query.Count is 0 then result
+//should point to a single document. Otherwise it should point to a slice.
+func (db *Database) Last(query *FirstLastQuery, result interface{}) error {
+	return db.LastCtx(context.Background(), query, result)
+}
+
+//LastCtx is like Last but the request is bound to ctx.
+func (db *Database) LastCtx(ctx context.Context, query *FirstLastQuery, result interface{}) error {
+	return db.simpleDocument(ctx, "last", query, &firstLastResult{Result: result})
+}
+
+type lookupByKeysResult struct {
+	Documents interface{} `json:"documents"`
+}
+
+//LookupByKeys will call the PUT /_api/simple/lookup-by-keys endpoint.
+//documents should point to a slice. Keys that don't exist are skipped.
+func (db *Database) LookupByKeys(query *LookupByKeysQuery, documents interface{}) error {
+	return db.LookupByKeysCtx(context.Background(), query, documents)
+}
+
+//LookupByKeysCtx is like LookupByKeys but the request is bound to ctx.
+func (db *Database) LookupByKeysCtx(ctx context.Context, query *LookupByKeysQuery, documents interface{}) error {
+	return db.simpleDocument(ctx, "lookup-by-keys", query, &lookupByKeysResult{Documents: documents})
+}
+
+//simpleCursor sends query to one of the /_api/simple endpoints
+//that answer with a cursor.
+func (db *Database) simpleCursor(ctx context.Context, path string, query interface{}) (*Cursor, error) {
+
+	var c = new(Cursor)
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/simple/%s",
+		db.serverUrl.String(),
+		path,
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, query, &c.json, &e)
+
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	switch response.Status() {
+	case 201:
+		c.db = db
+		return c, nil
+	default:
+		return nil, e
+	}
+}
+
+//simpleDocument sends query to one of the /_api/simple endpoints
+//that answer with the documents themselves.
+func (db *Database) simpleDocument(ctx context.Context, path string, query, result interface{}) error {
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/simple/%s",
+		db.serverUrl.String(),
+		path,
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Put(endpoint, query, result, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
+
+//ModifyByExampleOptions are the options available when removing,
+//replacing or updating documents by example. KeepNull and MergeObjects
+//only apply to UpdateByExample.
+type ModifyByExampleOptions struct {
+	//Wait until the changes have been synced to disk.
+	WaitForSync bool `json:"waitForSync,omitempty"`
+
+	//Limit caps the number of documents that are changed.
+	//If it is 0 then every matching document is changed.
+	Limit int `json:"limit,omitempty"`
+
+	//KeepNull stores attributes set to null in the new value
+	//instead of removing them.
+	KeepNull bool `json:"keepNull"`
+
+	//MergeObjects merges objects in the new value with the
+	//existing ones instead of replacing them.
+	MergeObjects bool `json:"mergeObjects"`
+}
+
+//DefaultModifyByExampleOptions returns options with default values according to arango.
+//Passing nil options to the modify by example methods is the same as using these.
+func DefaultModifyByExampleOptions() *ModifyByExampleOptions {
+	return &ModifyByExampleOptions{
+		KeepNull:     true,
+		MergeObjects: true,
+	}
+}
+
+//Small internal types used when modifying by example
+//so the options are nested the way arango wants them.
+type modifyByExample struct {
+	Collection string                  `json:"collection"`
+	Example    interface{}             `json:"example"`
+	NewValue   interface{}             `json:"newValue,omitempty"`
+	Options    *ModifyByExampleOptions `json:"options"`
+}
+
+type removeByKeys struct {
+	Collection string                  `json:"collection"`
+	Keys       []string                `json:"keys"`
+	Options    *ModifyByExampleOptions `json:"options"`
+}
+
+type modifyByExampleResult struct {
+	Deleted  int `json:"deleted"`
+	Replaced int `json:"replaced"`
+	Updated  int `json:"updated"`
+	Removed  int `json:"removed"`
+}
+
+//RemoveByExample removes every document that matches example and
+//returns how many were removed. options can be nil.
+//Uses the PUT /_api/simple/remove-by-example endpoint.
+func (c *Collection) RemoveByExample(example interface{}, options *ModifyByExampleOptions) (int, error) {
+	return c.RemoveByExampleCtx(context.Background(), example, options)
+}
+
+//RemoveByExampleCtx is like RemoveByExample but the request is bound to ctx.
+func (c *Collection) RemoveByExampleCtx(ctx context.Context, example interface{}, options *ModifyByExampleOptions) (int, error) {
+
+	var result modifyByExampleResult
+
+	err := c.db.simpleDocument(ctx, "remove-by-example", &modifyByExample{
+		Collection: c.Name(),
+		Example:    example,
+		Options:    modifyOptions(options),
+	}, &result)
+
+	return result.Deleted, err
+}
+
+//ReplaceByExample replaces every document that matches example with
+//newValue and returns how many were replaced. options can be nil.
+//Uses the PUT /_api/simple/replace-by-example endpoint.
+func (c *Collection) ReplaceByExample(example, newValue interface{}, options *ModifyByExampleOptions) (int, error) {
+	return c.ReplaceByExampleCtx(context.Background(), example, newValue, options)
+}
+
+//ReplaceByExampleCtx is like ReplaceByExample but the request is bound to ctx.
+func (c *Collection) ReplaceByExampleCtx(ctx context.Context, example, newValue interface{}, options *ModifyByExampleOptions) (int, error) {
+
+	if newValue == nil {
+		return 0, newError("You must provide a new value when calling ReplaceByExample.")
+	}
+
+	var result modifyByExampleResult
+
+	err := c.db.simpleDocument(ctx, "replace-by-example", &modifyByExample{
+		Collection: c.Name(),
+		Example:    example,
+		NewValue:   newValue,
+		Options:    modifyOptions(options),
+	}, &result)
+
+	return result.Replaced, err
+}
+
+//UpdateByExample partially updates every document that matches example
+//with newValue and returns how many were updated. options can be nil.
+//Uses the PUT /_api/simple/update-by-example endpoint.
+func (c *Collection) UpdateByExample(example, newValue interface{}, options *ModifyByExampleOptions) (int, error) {
+	return c.UpdateByExampleCtx(context.Background(), example, newValue, options)
+}
+
+//UpdateByExampleCtx is like UpdateByExample but the request is bound to ctx.
+func (c *Collection) UpdateByExampleCtx(ctx context.Context, example, newValue interface{}, options *ModifyByExampleOptions) (int, error) {
+
+	if newValue == nil {
+		return 0, newError("You must provide a new value when calling UpdateByExample.")
+	}
+
+	var result modifyByExampleResult
+
+	err := c.db.simpleDocument(ctx, "update-by-example", &modifyByExample{
+		Collection: c.Name(),
+		Example:    example,
+		NewValue:   newValue,
+		Options:    modifyOptions(options),
+	}, &result)
+
+	return result.Updated, err
+}
+
+//RemoveByKeys removes the documents with the given keys and returns
+//how many were removed. Keys that don't exist are ignored.
+//Only WaitForSync of the options is used. options can be nil.
+//Uses the PUT /_api/simple/remove-by-keys endpoint.
+func (c *Collection) RemoveByKeys(keys []string, options *ModifyByExampleOptions) (int, error) {
+	return c.RemoveByKeysCtx(context.Background(), keys, options)
+}
+
+//RemoveByKeysCtx is like RemoveByKeys but the request is bound to ctx.
+func (c *Collection) RemoveByKeysCtx(ctx context.Context, keys []string, options *ModifyByExampleOptions) (int, error) {
+
+	if keys == nil {
+		keys = []string{}
+	}
+
+	var result modifyByExampleResult
+
+	err := c.db.simpleDocument(ctx, "remove-by-keys", &removeByKeys{
+		Collection: c.Name(),
+		Keys:       keys,
+		Options:    modifyOptions(options),
+	}, &result)
+
+	return result.Removed, err
+}
+
+func modifyOptions(options *ModifyByExampleOptions) *ModifyByExampleOptions {
+	if options == nil {
+		return DefaultModifyByExampleOptions()
+	}
+	return options
+}
@@ -1,6 +1,12 @@
 package arango
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -157,3 +163,321 @@ func TestSearchByExample(t *testing.T) {
         t.Fatal( "Expected an error but didn't get one.")
     }
 }
+
+func TestSimpleQueries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	db := db
+
+	type place struct {
+		DocumentImplementation
+		Name     string     `json:"name"`
+		Num      int        `json:"num"`
+		Loc      [2]float64 `json:"loc"`
+		Text     string     `json:"text"`
+		Distance float64    `json:"distance,omitempty"`
+	}
+
+	c, err := db.CreateDocumentCollection("places")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.EnsureSkiplistIndex([]string{"num"}, nil)
+	c.EnsureGeoIndex([]string{"loc"}, false)
+	c.EnsureFulltextIndex("text", 0)
+
+	docs := []*place{
+		{Name: "a", Num: 1, Loc: [2]float64{0, 0}, Text: "hello world"},
+		{Name: "b", Num: 2, Loc: [2]float64{0, 1}, Text: "goodbye world"},
+		{Name: "c", Num: 3, Loc: [2]float64{10, 10}, Text: "hello there"},
+	}
+
+	for _, d := range docs {
+		if err = c.Save(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count := func(cur *Cursor, err error) int {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for cur.HasMore() {
+			var p place
+			if err := cur.Next(&p); err != nil {
+				t.Fatal(err)
+			}
+			n++
+		}
+		return n
+	}
+
+	if n := count(c.All(nil)); n != 3 {
+		t.Fatalf("Expected All to return 3 documents but got %d", n)
+	}
+
+	if n := count(c.All(&AllQuery{Limit: 2, BatchSize: 1})); n != 2 {
+		t.Fatalf("Expected All with a limit to return 2 documents but got %d", n)
+	}
+
+	if n := count(c.Range(&RangeQuery{Attribute: "num", Left: 1, Right: 3})); n != 2 {
+		t.Fatalf("Expected Range to return 2 documents but got %d", n)
+	}
+
+	if n := count(c.Range(&RangeQuery{Attribute: "num", Left: 1, Right: 3, Closed: true})); n != 3 {
+		t.Fatalf("Expected a closed Range to return 3 documents but got %d", n)
+	}
+
+	cur, err := c.Near(&NearQuery{Latitude: 0, Longitude: 0, Limit: 1, Distance: "distance"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nearest place
+	if err = cur.Next(&nearest); err != nil || nearest.Name != "a" {
+		t.Fatalf("Expected a to be the nearest: %+v %v", nearest, err)
+	}
+
+	if n := count(c.Within(&WithinQuery{Latitude: 0, Longitude: 0, Radius: 200000})); n != 2 {
+		t.Fatalf("Expected Within to return 2 documents but got %d", n)
+	}
+
+	if n := count(c.Fulltext(&FulltextQuery{Attribute: "text", Query: "hello"})); n != 2 {
+		t.Fatalf("Expected Fulltext to return 2 documents but got %d", n)
+	}
+
+	var any place
+	if err = c.Any(&any); err != nil || any.Name == "" {
+		t.Fatalf("Expected Any to return a document: %+v %v", any, err)
+	}
+
+	var first place
+	if err = c.First(0, &first); err != nil || first.Name != "a" {
+		t.Fatalf("Expected First to return a: %+v %v", first, err)
+	}
+
+	var last []place
+	if err = c.Last(2, &last); err != nil || len(last) != 2 || last[0].Name != "c" {
+		t.Fatalf("Expected Last to return c and b: %+v %v", last, err)
+	}
+
+	var found []place
+	if err = c.LookupByKeys([]string{docs[0].Key(), docs[2].Key(), "nope"}, &found); err != nil || len(found) != 2 {
+		t.Fatalf("Expected LookupByKeys to return 2 documents: %+v %v", found, err)
+	}
+}
+
+func TestSimpleQueryRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		var query map[string]interface{}
+		json.Unmarshal(b, &query)
+		switch {
+		case r.URL.Path == "/_db/_system/_api/collection":
+			fmt.Fprint(w, `{"id":"1","name":"places","status":3,"type":2}`)
+		case strings.HasSuffix(r.URL.Path, "/any"):
+			fmt.Fprint(w, `{"document":{"_key":"1","Hi":"any"},"error":false,"code":200}`)
+		case strings.HasSuffix(r.URL.Path, "/first"), strings.HasSuffix(r.URL.Path, "/last"):
+			if query["count"] == nil {
+				fmt.Fprint(w, `{"result":{"_key":"1","Hi":"one"},"error":false,"code":200}`)
+			} else {
+				fmt.Fprint(w, `{"result":[{"_key":"1","Hi":"one"},{"_key":"2","Hi":"two"}],"error":false,"code":200}`)
+			}
+		case strings.HasSuffix(r.URL.Path, "/lookup-by-keys"):
+			fmt.Fprint(w, `{"documents":[{"_key":"1","Hi":"one"}],"error":false,"code":200}`)
+		default:
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"result":[{"_key":"1","Hi":"one"}],"hasMore":false,"count":1,"error":false,"code":201}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("places")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.Near(nil); err == nil {
+		t.Fatal("Expected an error when calling Near without a query.")
+	}
+
+	rec.reset()
+
+	cur, err := c.All(nil)
+
+	if err != nil || cur.Count() != 1 {
+		t.Fatalf("Expected a cursor with one document: %v", err)
+	}
+
+	query := &RangeQuery{Collection: "other", Attribute: "num", Left: 1, Right: 5, Limit: 2}
+	c.Range(query)
+
+	if query.Collection != "other" {
+		t.Fatal("Expected the query passed in to be left alone.")
+	}
+
+	c.Near(&NearQuery{Latitude: 1.5, Longitude: 0, Distance: "d"})
+	c.Within(&WithinQuery{Latitude: 1, Longitude: 2, Radius: 100, BatchSize: 5})
+	c.Fulltext(&FulltextQuery{Attribute: "text", Query: "prefix:hel"})
+
+	var doc DummyDocument
+	if err = c.Any(&doc); err != nil || doc.Hi != "any" {
+		t.Fatalf("Expected Any to fill in the document: %+v %v", doc, err)
+	}
+
+	if err = c.First(0, &doc); err != nil || doc.Hi != "one" {
+		t.Fatalf("Expected First to fill in the document: %+v %v", doc, err)
+	}
+
+	var docs []DummyDocument
+	if err = c.Last(2, &docs); err != nil || len(docs) != 2 {
+		t.Fatalf("Expected Last to fill in the slice: %+v %v", docs, err)
+	}
+
+	docs = nil
+	if err = c.LookupByKeys([]string{"1", "2"}, &docs); err != nil || len(docs) != 1 {
+		t.Fatalf("Expected LookupByKeys to fill in the slice: %+v %v", docs, err)
+	}
+
+	rec.check(t,
+		`PUT /simple/all {"collection":"places"}`,
+		`PUT /simple/range {"collection":"places","attribute":"num","left":1,"right":5,"limit":2}`,
+		`PUT /simple/near {"collection":"places","latitude":1.5,"longitude":0,"distance":"d"}`,
+		`PUT /simple/within {"collection":"places","latitude":1,"longitude":2,"radius":100,"batchSize":5}`,
+		`PUT /simple/fulltext {"collection":"places","attribute":"text","query":"prefix:hel"}`,
+		`PUT /simple/any {"collection":"places"}`,
+		`PUT /simple/first {"collection":"places"}`,
+		`PUT /simple/last {"collection":"places","count":2}`,
+		`PUT /simple/lookup-by-keys {"collection":"places","keys":["1","2"]}`,
+	)
+}
+
+func TestModifyByExample(t *testing.T) {
+	setup()
+	defer teardown()
+
+	c, err := db.CreateDocumentCollection("modify")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type item struct {
+		DocumentImplementation
+		Kind  string `json:"kind"`
+		State string `json:"state,omitempty"`
+	}
+
+	var items []*item
+	for _, kind := range []string{"a", "a", "a", "b", "c"} {
+		i := &item{Kind: kind}
+		if err = c.Save(i); err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, i)
+	}
+
+	n, err := c.UpdateByExample(map[string]string{"kind": "a"}, map[string]string{"state": "done"}, nil)
+
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 updated documents: %d %v", n, err)
+	}
+
+	options := DefaultModifyByExampleOptions()
+	options.Limit = 1
+	n, err = c.ReplaceByExample(map[string]string{"kind": "b"}, map[string]string{"kind": "d"}, options)
+
+	if err != nil || n != 1 {
+		t.Fatalf("Expected 1 replaced document: %d %v", n, err)
+	}
+
+	n, err = c.RemoveByExample(map[string]string{"state": "done"}, &ModifyByExampleOptions{WaitForSync: true})
+
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 removed documents: %d %v", n, err)
+	}
+
+	n, err = c.RemoveByKeys([]string{items[4].Key(), "missing"}, nil)
+
+	if err != nil || n != 1 {
+		t.Fatalf("Expected 1 document removed by key: %d %v", n, err)
+	}
+
+	if count, _ := c.Count(); count != 1 {
+		t.Fatalf("Expected 1 document to be left but got %d", count)
+	}
+}
+
+func TestModifyByExampleRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/_db/_system/_api/collection":
+			fmt.Fprint(w, `{"id":"1","name":"modify","status":3,"type":2}`)
+		case strings.HasSuffix(r.URL.Path, "/remove-by-keys"):
+			fmt.Fprint(w, `{"removed":2,"ignored":1,"error":false,"code":200}`)
+		case strings.HasSuffix(r.URL.Path, "/update-by-example"):
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"error":true,"code":404,"errorNum":1203,"errorMessage":"collection not found"}`)
+		default:
+			fmt.Fprint(w, `{"deleted":4,"replaced":5,"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := db.CreateDocumentCollection("modify")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.UpdateByExample(map[string]int{}, nil, nil); err == nil {
+		t.Fatal("Expected an error when updating without a new value.")
+	}
+
+	rec.reset()
+
+	if n, err := c.RemoveByExample(map[string]int{"a": 1}, nil); err != nil || n != 4 {
+		t.Fatalf("Expected 4 removed documents: %d %v", n, err)
+	}
+
+	if n, err := c.ReplaceByExample(map[string]int{"a": 1}, map[string]int{"b": 2}, &ModifyByExampleOptions{Limit: 3}); err != nil || n != 5 {
+		t.Fatalf("Expected 5 replaced documents: %d %v", n, err)
+	}
+
+	if _, err := c.UpdateByExample(map[string]int{"a": 1}, map[string]int{"b": 2}, nil); !errors.Is(err, ErrCollectionNotFound) {
+		t.Fatalf("Expected a collection not found error but got %v", err)
+	}
+
+	if n, err := c.RemoveByKeys([]string{"1", "2", "3"}, &ModifyByExampleOptions{WaitForSync: true}); err != nil || n != 2 {
+		t.Fatalf("Expected 2 removed documents: %d %v", n, err)
+	}
+
+	rec.check(t,
+		`PUT /simple/remove-by-example {"collection":"modify","example":{"a":1},"options":{"keepNull":true,"mergeObjects":true}}`,
+		`PUT /simple/replace-by-example {"collection":"modify","example":{"a":1},"newValue":{"b":2},"options":{"limit":3,"keepNull":false,"mergeObjects":false}}`,
+		`PUT /simple/update-by-example {"collection":"modify","example":{"a":1},"newValue":{"b":2},"options":{"keepNull":true,"mergeObjects":true}}`,
+		`PUT /simple/remove-by-keys {"collection":"modify","keys":["1","2","3"],"options":{"waitForSync":true,"keepNull":false,"mergeObjects":false}}`,
+	)
+}
@@ -0,0 +1,119 @@
+package arango
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+//Transaction represents a server side javascript transaction
+//that is sent to the POST /_api/transaction endpoint.
+//
+//Every collection the action touches must be declared in Read or
+//Write. Arango runs the whole action atomically and rolls it back
+//if it throws.
+type Transaction struct {
+	//Action is the javascript function that arango executes, for example
+	//  function (params) { var db = require("internal").db; ... }
+	//Whatever it returns is handed back as the result of the transaction.
+	Action string
+
+	//Read lists the collections the action reads from.
+	Read []string
+
+	//Write lists the collections the action writes to.
+	Write []string
+
+	//WaitForSync forces the transaction to be synced to disk before returning.
+	WaitForSync bool
+
+	//LockTimeout is the number of seconds to wait for the collection
+	//locks. If 0 then it is ignored and arango uses its default.
+	LockTimeout int
+
+	//Params is passed to the action as its only argument.
+	Params interface{}
+}
+
+//NewTransaction is a shortcut for creating a Transaction
+//that runs action and writes to the write collections.
+func NewTransaction(action string, write ...string) *Transaction {
+	return &Transaction{
+		Action: action,
+		Write:  write,
+	}
+}
+
+//Small internal types used when sending the transaction
+//so the collections are nested the way arango wants them.
+type transactionPayload struct {
+	Collections transactionCollections `json:"collections"`
+	Action      string                 `json:"action"`
+	WaitForSync bool                   `json:"waitForSync,omitempty"`
+	LockTimeout int                    `json:"lockTimeout,omitempty"`
+	Params      interface{}            `json:"params,omitempty"`
+}
+
+type transactionCollections struct {
+	Read  []string `json:"read,omitempty"`
+	Write []string `json:"write,omitempty"`
+}
+
+type transactionResult struct {
+	Result json.RawMessage `json:"result"`
+}
+
+//Transaction runs transaction using the POST /_api/transaction endpoint.
+//The value returned by the action is unmarshalled into result,
+//which can be nil if you don't care about it.
+//If the action throws, the transaction is rolled back and the
+//error is returned as an ArangoError.
+func (db *Database) Transaction(transaction *Transaction, result interface{}) error {
+	return db.TransactionCtx(context.Background(), transaction, result)
+}
+
+//TransactionCtx is like Transaction but the request is bound to ctx.
+//Cancelling ctx does not abort a transaction the server already started.
+func (db *Database) TransactionCtx(ctx context.Context, transaction *Transaction, result interface{}) error {
+
+	if transaction == nil || transaction.Action == "" {
+		return newError("You must provide an action when calling Transaction.")
+	}
+
+	var payload = transactionPayload{
+		Collections: transactionCollections{
+			Read:  transaction.Read,
+			Write: transaction.Write,
+		},
+		Action:      transaction.Action,
+		WaitForSync: transaction.WaitForSync,
+		LockTimeout: transaction.LockTimeout,
+		Params:      transaction.Params,
+	}
+
+	var r transactionResult
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/transaction",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	response, err := session.Post(endpoint, &payload, &r, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200:
+		if result != nil && len(r.Result) > 0 {
+			if err := json.Unmarshal(r.Result, result); err != nil {
+				return wrapError(err)
+			}
+		}
+		return nil
+	default:
+		return e
+	}
+}
@@ -0,0 +1,127 @@
+package arango
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestTransaction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	db := db
+
+	c, err := db.CreateDocumentCollection("accounts")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := &DummyFullDocument{Hi: "from"}
+	to := &DummyFullDocument{Hi: "to"}
+	c.Save(from)
+	c.Save(to)
+
+	tx := NewTransaction(`function (params) {
+		var db = require("internal").db;
+		db.accounts.update(params.from, {Hi: "sent"});
+		db.accounts.update(params.to, {Hi: "received"});
+		return db.accounts.count();
+	}`, "accounts")
+	tx.Params = map[string]string{"from": from.Key(), "to": to.Key()}
+
+	var count int
+
+	if err = db.Transaction(tx, &count); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 2 {
+		t.Fatalf("Expected the transaction to return 2 but got %d", count)
+	}
+
+	fetched := &DummyFullDocument{}
+	if err = c.Document(from.Key(), fetched); err != nil || fetched.Hi != "sent" {
+		t.Fatalf("Expected the transaction to update the document: %v %+v", err, fetched)
+	}
+
+	tx = NewTransaction(`function (params) {
+		var db = require("internal").db;
+		db.accounts.update(params.from, {Hi: "lost"});
+		throw "rollback";
+	}`, "accounts")
+	tx.Params = map[string]string{"from": from.Key()}
+
+	if err = db.Transaction(tx, nil); err == nil {
+		t.Fatal("Expected an error when the action throws.")
+	}
+
+	if err = c.Document(from.Key(), fetched); err != nil || fetched.Hi != "sent" {
+		t.Fatalf("Expected the failed transaction to be rolled back: %v %+v", err, fetched)
+	}
+}
+
+func TestTransactionPayload(t *testing.T) {
+
+	var payload map[string]interface{}
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_db/_system/_api/transaction" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &payload)
+		if payload["action"] == "fail" {
+			w.WriteHeader(400)
+			fmt.Fprint(w, `{"error":true,"code":400,"errorNum":1650,"errorMessage":"transaction aborted"}`)
+			return
+		}
+		fmt.Fprint(w, `{"result":{"moved":100},"error":false,"code":200}`)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Transaction(&Transaction{}, nil); err == nil {
+		t.Fatal("Expected an error when no action is given.")
+	}
+
+	var result struct {
+		Moved int `json:"moved"`
+	}
+
+	err = db.Transaction(&Transaction{
+		Action:      "function (p) { return {moved: p.amount}; }",
+		Read:        []string{"rates"},
+		Write:       []string{"accounts", "ledger"},
+		WaitForSync: true,
+		LockTimeout: 5,
+		Params:      map[string]int{"amount": 100},
+	}, &result)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Moved != 100 {
+		t.Fatalf("Expected the result to be unmarshalled but got %+v", result)
+	}
+
+	expected := `map[action:function (p) { return {moved: p.amount}; } collections:map[read:[rates] write:[accounts ledger]] lockTimeout:5 params:map[amount:100] waitForSync:true]`
+
+	if fmt.Sprint(payload) != expected {
+		t.Fatalf("Unexpected payload %v", payload)
+	}
+
+	err = db.Transaction(NewTransaction("fail", "accounts"), nil)
+
+	if e, ok := err.(ArangoError); !ok || e.ErrorNum != ERROR_TRANSACTION_INTERNAL {
+		t.Fatalf("Expected an ArangoError but got %v", err)
+	}
+}
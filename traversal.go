@@ -0,0 +1,357 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+)
+
+//Traversal strategies, orders and uniqueness levels
+//
+//See arango manual or rest api docs for what these might mean
+const (
+	STRATEGY_DEPTH_FIRST   = "depthfirst"
+	STRATEGY_BREADTH_FIRST = "breadthfirst"
+
+	ORDER_PREORDER          = "preorder"
+	ORDER_POSTORDER         = "postorder"
+	ORDER_PREORDER_EXPANDER = "preorder-expander"
+
+	UNIQUE_NONE   = "none"
+	UNIQUE_GLOBAL = "global"
+	UNIQUE_PATH   = "path"
+)
+
+//Traversal describes a graph traversal that is sent to the
+//POST /_api/traversal endpoint. Set either GraphName or EdgeCollection.
+type Traversal struct {
+	//StartVertex must be a full document id or implement HasArangoId.
+	StartVertex interface{}
+
+	GraphName      string
+	EdgeCollection string
+
+	//Direction is one of DIRECTION_ANY, DIRECTION_IN or DIRECTION_OUT.
+	//Blank means DIRECTION_ANY.
+	Direction string
+
+	MinDepth int
+	MaxDepth int
+
+	//Uniqueness of vertices and edges on the walk. Each is one of
+	//UNIQUE_NONE, UNIQUE_GLOBAL or UNIQUE_PATH. Blank means the arango default.
+	UniqueVertices string
+	UniqueEdges    string
+
+	//Strategy is STRATEGY_DEPTH_FIRST or STRATEGY_BREADTH_FIRST.
+	Strategy string
+
+	//Order is ORDER_PREORDER, ORDER_POSTORDER or ORDER_PREORDER_EXPANDER.
+	Order string
+
+	//ItemOrder is "forward" or "backward".
+	ItemOrder string
+
+	//Visitor, Filter and Init are javascript function bodies. See the
+	//arango manual for their arguments. With a custom Visitor or Init
+	//the result has whatever shape they give it.
+	Visitor string
+	Filter  string
+	Init    string
+
+	//MaxIterations protects against endless loops in cyclic graphs.
+	MaxIterations int
+}
+
+//TraversalResult can be passed to Traverse to decode the vertices and
+//paths the default visitor collects. Point Vertices to a slice of your
+//vertex type and Paths to a slice of a type like:
+//
+//  type MyPath struct {
+//  	Edges    []MyEdge   `json:"edges"`
+//  	Vertices []MyVertex `json:"vertices"`
+//  }
+//
+//Either can be left nil.
+type TraversalResult struct {
+	Vertices interface{} `json:"vertices,omitempty"`
+	Paths    interface{} `json:"paths,omitempty"`
+}
+
+//Small internal types used when sending the traversal
+//so it is shaped the way arango wants it.
+type traversalPayload struct {
+	StartVertex    string               `json:"startVertex"`
+	GraphName      string               `json:"graphName,omitempty"`
+	EdgeCollection string               `json:"edgeCollection,omitempty"`
+	Direction      string               `json:"direction,omitempty"`
+	MinDepth       int                  `json:"minDepth,omitempty"`
+	MaxDepth       int                  `json:"maxDepth,omitempty"`
+	Uniqueness     *traversalUniqueness `json:"uniqueness,omitempty"`
+	Strategy       string               `json:"strategy,omitempty"`
+	Order          string               `json:"order,omitempty"`
+	ItemOrder      string               `json:"itemOrder,omitempty"`
+	Visitor        string               `json:"visitor,omitempty"`
+	Filter         string               `json:"filter,omitempty"`
+	Init           string               `json:"init,omitempty"`
+	MaxIterations  int                  `json:"maxIterations,omitempty"`
+}
+
+type traversalUniqueness struct {
+	Vertices string `json:"vertices,omitempty"`
+	Edges    string `json:"edges,omitempty"`
+}
+
+type traversalResponse struct {
+	Result struct {
+		Visited interface{} `json:"visited"`
+	} `json:"result"`
+}
+
+//Traverse walks a graph using the POST /_api/traversal endpoint and
+//decodes what the visitor collected into result. With the default visitor
+//pass a *TraversalResult. Otherwise pass anything matching your visitor.
+func (db *Database) Traverse(traversal *Traversal, result interface{}) error {
+	return db.TraverseCtx(context.Background(), traversal, result)
+}
+
+//TraverseCtx is like Traverse but the request is bound to ctx.
+func (db *Database) TraverseCtx(ctx context.Context, traversal *Traversal, result interface{}) error {
+
+	if traversal == nil {
+		return newError("You must provide a traversal when calling Traverse.")
+	}
+
+	if traversal.GraphName == "" && traversal.EdgeCollection == "" {
+		return newError("You must specify a graph name or an edge collection when traversing.")
+	}
+
+	start, _, err := resolveHandle(traversal.StartVertex)
+
+	if err != nil {
+		return newError("The start vertex must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	var direction string
+	switch traversal.Direction {
+	case "", DIRECTION_ANY:
+		direction = "any"
+	case DIRECTION_IN:
+		direction = "inbound"
+	case DIRECTION_OUT:
+		direction = "outbound"
+	default:
+		return newError(fmt.Sprintf("Unknown edge direction %q.", traversal.Direction))
+	}
+
+	var payload = traversalPayload{
+		StartVertex:    start,
+		GraphName:      traversal.GraphName,
+		EdgeCollection: traversal.EdgeCollection,
+		Direction:      direction,
+		MinDepth:       traversal.MinDepth,
+		MaxDepth:       traversal.MaxDepth,
+		Strategy:       traversal.Strategy,
+		Order:          traversal.Order,
+		ItemOrder:      traversal.ItemOrder,
+		Visitor:        traversal.Visitor,
+		Filter:         traversal.Filter,
+		Init:           traversal.Init,
+		MaxIterations:  traversal.MaxIterations,
+	}
+
+	if traversal.UniqueVertices != "" || traversal.UniqueEdges != "" {
+		payload.Uniqueness = &traversalUniqueness{
+			Vertices: traversal.UniqueVertices,
+			Edges:    traversal.UniqueEdges,
+		}
+	}
+
+	var response traversalResponse
+	response.Result.Visited = result
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/traversal",
+		db.serverUrl.String(),
+	)
+
+	session := db.sessionCtx(ctx)
+	r, err := session.Post(endpoint, &payload, &response, &e)
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch r.Status() {
+	case 200:
+		return nil
+	default:
+		return e
+	}
+}
+
+//Traverse walks the graph. traversal.GraphName is set to the name
+//of the graph. See db.Traverse.
+func (g *Graph) Traverse(traversal *Traversal, result interface{}) error {
+	return g.TraverseCtx(context.Background(), traversal, result)
+}
+
+//TraverseCtx is like Traverse but the request is bound to ctx.
+func (g *Graph) TraverseCtx(ctx context.Context, traversal *Traversal, result interface{}) error {
+	if traversal == nil {
+		return newError("You must provide a traversal when calling Traverse.")
+	}
+	copied := *traversal
+	copied.GraphName = g.Name()
+	copied.EdgeCollection = ""
+	return g.db.TraverseCtx(ctx, &copied, result)
+}
+
+type shortestPathResult struct {
+	Vertices interface{} `json:"vertices"`
+	Edges    interface{} `json:"edges"`
+}
+
+//ShortestPath finds the shortest path between from and to and decodes
+//the vertices on it, including from and to, into vertices and the
+//edges along it into edges. Both should point to slices and either
+//can be nil. If there is no path both are left empty.
+//direction is one of DIRECTION_ANY, DIRECTION_IN or DIRECTION_OUT. Blank means any.
+func (g *Graph) ShortestPath(from, to interface{}, direction string, vertices, edges interface{}) error {
+	return g.ShortestPathCtx(context.Background(), from, to, direction, vertices, edges)
+}
+
+//ShortestPathCtx is like ShortestPath but the request is bound to ctx.
+func (g *Graph) ShortestPathCtx(ctx context.Context, from, to interface{}, direction string, vertices, edges interface{}) error {
+
+	fromId, _, err := resolveHandle(from)
+
+	if err != nil {
+		return newError("The \"from\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	toId, _, err := resolveHandle(to)
+
+	if err != nil {
+		return newError("The \"to\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	keyword, err := aqlDirection(direction)
+
+	if err != nil {
+		return err
+	}
+
+	query := NewAqlQuery(fmt.Sprintf(`LET p = (FOR v, e IN %s SHORTEST_PATH @from TO @to GRAPH @graph RETURN {v: v, e: e})
+RETURN {vertices: p[*].v, edges: p[* FILTER CURRENT.e != null].e}`, keyword)).
+		Bind("from", fromId).
+		Bind("to", toId).
+		Bind("graph", g.Name())
+
+	return g.queryOne(ctx, query, &shortestPathResult{Vertices: vertices, Edges: edges})
+}
+
+//Neighbors decodes the vertices reachable from vertex within maxDepth
+//steps into vertices, which should point to a slice. Every vertex is
+//returned once and vertex itself is left out. A maxDepth of 0 means 1.
+func (g *Graph) Neighbors(vertex interface{}, direction string, maxDepth int, vertices interface{}) error {
+	return g.NeighborsCtx(context.Background(), vertex, direction, maxDepth, vertices)
+}
+
+//NeighborsCtx is like Neighbors but the request is bound to ctx.
+func (g *Graph) NeighborsCtx(ctx context.Context, vertex interface{}, direction string, maxDepth int, vertices interface{}) error {
+
+	id, _, err := resolveHandle(vertex)
+
+	if err != nil {
+		return newError("The vertex must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	keyword, err := aqlDirection(direction)
+
+	if err != nil {
+		return err
+	}
+
+	query := NewAqlQuery(fmt.Sprintf(`RETURN (FOR v IN 1..@depth %s @start GRAPH @graph OPTIONS {bfs: true, uniqueVertices: "global"} FILTER v._id != @start RETURN v)`, keyword)).
+		Bind("start", id).
+		Bind("depth", neighborDepth(maxDepth)).
+		Bind("graph", g.Name())
+
+	return g.queryOne(ctx, query, vertices)
+}
+
+//CommonNeighbors decodes the vertices that are neighbors of both a and b
+//within maxDepth steps into vertices, which should point to a slice.
+//See Neighbors.
+func (g *Graph) CommonNeighbors(a, b interface{}, direction string, maxDepth int, vertices interface{}) error {
+	return g.CommonNeighborsCtx(context.Background(), a, b, direction, maxDepth, vertices)
+}
+
+//CommonNeighborsCtx is like CommonNeighbors but the request is bound to ctx.
+func (g *Graph) CommonNeighborsCtx(ctx context.Context, a, b interface{}, direction string, maxDepth int, vertices interface{}) error {
+
+	aId, _, err := resolveHandle(a)
+
+	if err != nil {
+		return newError("The \"a\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	bId, _, err := resolveHandle(b)
+
+	if err != nil {
+		return newError("The \"b\" parameter must be a valid document handle. (It must be a string or implement HasArangoId)")
+	}
+
+	keyword, err := aqlDirection(direction)
+
+	if err != nil {
+		return err
+	}
+
+	query := NewAqlQuery(fmt.Sprintf(`LET others = (FOR v IN 1..@depth %[1]s @b GRAPH @graph OPTIONS {bfs: true, uniqueVertices: "global"} RETURN v._id)
+RETURN (FOR v IN 1..@depth %[1]s @a GRAPH @graph OPTIONS {bfs: true, uniqueVertices: "global"} FILTER v._id IN others && v._id != @a && v._id != @b RETURN v)`, keyword)).
+		Bind("a", aId).
+		Bind("b", bId).
+		Bind("depth", neighborDepth(maxDepth)).
+		Bind("graph", g.Name())
+
+	return g.queryOne(ctx, query, vertices)
+}
+
+//queryOne runs an AQL query that returns a single value and decodes it into result.
+func (g *Graph) queryOne(ctx context.Context, query *AqlQuery, result interface{}) error {
+
+	cursor, err := g.db.QueryCtx(ctx, query)
+
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return cursor.NextCtx(ctx, result)
+}
+
+//aqlDirection turns one of the DIRECTION_* constants into the AQL keyword.
+//Directions can't be bind parameters so they are checked here instead.
+func aqlDirection(direction string) (string, error) {
+	switch direction {
+	case "", DIRECTION_ANY:
+		return "ANY", nil
+	case DIRECTION_IN:
+		return "INBOUND", nil
+	case DIRECTION_OUT:
+		return "OUTBOUND", nil
+	default:
+		return "", newError(fmt.Sprintf("Unknown edge direction %q.", direction))
+	}
+}
+
+func neighborDepth(maxDepth int) int {
+	if maxDepth < 1 {
+		return 1
+	}
+	return maxDepth
+}
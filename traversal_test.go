@@ -0,0 +1,209 @@
+package arango
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type traversalPath struct {
+	Edges    []EdgeImplementation `json:"edges"`
+	Vertices []DummyFullDocument  `json:"vertices"`
+}
+
+func TestTraversal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	g, err := db.CreateGraph("walk", []EdgeDefinition{
+		{Collection: "links", From: []string{"nodes"}, To: []string{"nodes"}},
+	}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//a -> b -> c -> d and a -> e -> d
+	nodes := map[string]*DummyFullDocument{}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		nodes[name] = &DummyFullDocument{Hi: name}
+		if err = g.SaveVertex("nodes", nodes[name], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, link := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"a", "e"}, {"e", "d"}} {
+		if err = g.SaveEdge("links", nodes[link[0]], nodes[link[1]], &EdgeImplementation{}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var vertices []DummyFullDocument
+	var paths []traversalPath
+
+	err = g.Traverse(&Traversal{
+		StartVertex:    nodes["a"],
+		Direction:      DIRECTION_OUT,
+		MaxDepth:       1,
+		Strategy:       STRATEGY_BREADTH_FIRST,
+		UniqueVertices: UNIQUE_GLOBAL,
+	}, &TraversalResult{Vertices: &vertices, Paths: &paths})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vertices) != 3 || vertices[0].Hi != "a" || len(paths) != 3 || len(paths[1].Edges) != 1 {
+		t.Fatalf("Expected a and its two neighbors: %+v %+v", vertices, paths)
+	}
+
+	var count int
+	err = db.Traverse(&Traversal{
+		StartVertex:    nodes["a"].Id(),
+		EdgeCollection: "links",
+		Direction:      DIRECTION_OUT,
+		Init:           "result.count = 0;",
+		Visitor:        "result.count++;",
+	}, &struct {
+		Count *int `json:"count"`
+	}{&count})
+
+	if err != nil || count < 5 {
+		t.Fatalf("Expected the custom visitor to count the vertices: %d %v", count, err)
+	}
+
+	var path []DummyFullDocument
+	var edges []EdgeImplementation
+
+	if err = g.ShortestPath(nodes["a"], nodes["d"], DIRECTION_OUT, &path, &edges); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(path) != 3 || path[0].Hi != "a" || path[1].Hi != "e" || path[2].Hi != "d" || len(edges) != 2 {
+		t.Fatalf("Expected the path a, e, d: %+v %+v", path, edges)
+	}
+
+	var neighbors []DummyFullDocument
+
+	if err = g.Neighbors(nodes["a"], DIRECTION_OUT, 2, &neighbors); err != nil || len(neighbors) != 4 {
+		t.Fatalf("Expected b, c, d and e as neighbors: %+v %v", neighbors, err)
+	}
+
+	var common []DummyFullDocument
+
+	if err = g.CommonNeighbors(nodes["b"], nodes["e"], DIRECTION_ANY, 1, &common); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(common) != 1 || common[0].Hi != "a" {
+		t.Fatalf("Expected a to be the only common neighbor of b and e: %+v", common)
+	}
+}
+
+func TestTraversalRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		json.Unmarshal(b, &body)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/traversal"):
+			fmt.Fprint(w, `{"result":{"visited":{"vertices":[{"_id":"n/a","Hi":"a"}],"paths":[{"edges":[],"vertices":[{"_id":"n/a","Hi":"a"}]}]}},"error":false,"code":200}`)
+		case strings.Contains(body["query"].(string), "SHORTEST_PATH"):
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"result":[{"vertices":[{"Hi":"a"},{"Hi":"b"}],"edges":[{"_from":"n/a","_to":"n/b"}]}],"hasMore":false,"error":false,"code":201}`)
+		default:
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"result":[[{"Hi":"b"},{"Hi":"c"}]],"hasMore":false,"error":false,"code":201}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the queries are long so only parts of the bodies are checked
+	requests := func(i int) map[string]interface{} {
+		var body map[string]interface{}
+		json.Unmarshal(rec.body(i), &body)
+		return body
+	}
+
+	if err = db.Traverse(&Traversal{StartVertex: "n/a"}, nil); err == nil {
+		t.Fatal("Expected an error when neither a graph nor an edge collection is given.")
+	}
+
+	if err = db.Traverse(&Traversal{StartVertex: "n/a", GraphName: "g", Direction: "up"}, nil); err == nil {
+		t.Fatal("Expected an error for an unknown direction.")
+	}
+
+	g := &Graph{db: db, json: &graphResult{Name: "g"}}
+
+	var vertices []DummyFullDocument
+	var paths []traversalPath
+
+	err = g.Traverse(&Traversal{
+		StartVertex:    "n/a",
+		EdgeCollection: "ignored",
+		Direction:      DIRECTION_IN,
+		MinDepth:       1,
+		MaxDepth:       3,
+		UniqueVertices: UNIQUE_PATH,
+		Order:          ORDER_POSTORDER,
+		Filter:         "return;",
+	}, &TraversalResult{Vertices: &vertices, Paths: &paths})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vertices) != 1 || vertices[0].Hi != "a" || len(paths) != 1 || paths[0].Vertices[0].Id() != "n/a" {
+		t.Fatalf("Expected the visited vertices and paths to be decoded: %+v %+v", vertices, paths)
+	}
+
+	expected := `map[direction:inbound filter:return; graphName:g maxDepth:3 minDepth:1 order:postorder startVertex:n/a uniqueness:map[vertices:path]]`
+
+	if fmt.Sprint(requests(0)) != expected {
+		t.Fatalf("Unexpected traversal %v", requests(0))
+	}
+
+	var edges []EdgeImplementation
+
+	if err = g.ShortestPath("n/a", "n/b", DIRECTION_OUT, &vertices, &edges); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vertices) != 2 || vertices[1].Hi != "b" || len(edges) != 1 || edges[0].To() != "n/b" {
+		t.Fatalf("Expected the path to be decoded: %+v %+v", vertices, edges)
+	}
+
+	if !strings.Contains(requests(1)["query"].(string), "OUTBOUND SHORTEST_PATH @from TO @to GRAPH @graph") ||
+		fmt.Sprint(requests(1)["bindVars"]) != "map[from:n/a graph:g to:n/b]" {
+		t.Fatalf("Unexpected shortest path query %v", requests(1))
+	}
+
+	if err = g.Neighbors("n/a", "", 0, &vertices); err != nil || len(vertices) != 2 {
+		t.Fatalf("Expected the neighbors to be decoded: %+v %v", vertices, err)
+	}
+
+	if !strings.Contains(requests(2)["query"].(string), "1..@depth ANY @start") ||
+		fmt.Sprint(requests(2)["bindVars"]) != "map[depth:1 graph:g start:n/a]" {
+		t.Fatalf("Unexpected neighbors query %v", requests(2))
+	}
+
+	if err = g.CommonNeighbors("n/a", "n/b", DIRECTION_IN, 2, &vertices); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(requests(3)["query"].(string), "1..@depth INBOUND @b") ||
+		fmt.Sprint(requests(3)["bindVars"]) != "map[a:n/a b:n/b depth:2 graph:g]" {
+		t.Fatalf("Unexpected common neighbors query %v", requests(3))
+	}
+}
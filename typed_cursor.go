@@ -0,0 +1,202 @@
+package arango
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+)
+
+//TypedCursor wraps a Cursor and decodes every result into a T
+//so you don't have to check HasMore and pass a pointer to Next
+//yourself. The server side cursor is closed as soon as the results
+//run out, an error happens or a range loop over All stops early.
+//
+//  cur, err := arango.QueryTyped[User](db, q)
+//  ...
+//  for user, err := range cur.All() {
+//      ...
+//  }
+type TypedCursor[T any] struct {
+	cursor *Cursor
+}
+
+//NewTypedCursor wraps c in a TypedCursor.
+func NewTypedCursor[T any](c *Cursor) *TypedCursor[T] {
+	return &TypedCursor[T]{cursor: c}
+}
+
+//QueryTyped runs query like Database.Query and returns the
+//results as a TypedCursor.
+func QueryTyped[T any](db *Database, query *AqlQuery) (*TypedCursor[T], error) {
+	return QueryTypedCtx[T](context.Background(), db, query)
+}
+
+//QueryTypedCtx is like QueryTyped but the request is bound to ctx.
+func QueryTypedCtx[T any](ctx context.Context, db *Database, query *AqlQuery) (*TypedCursor[T], error) {
+	c, err := db.QueryCtx(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTypedCursor[T](c), nil
+}
+
+//Cursor returns the underlying Cursor, for things like
+//Count and Stats.
+func (t *TypedCursor[T]) Cursor() *Cursor {
+	return t.cursor
+}
+
+func (t *TypedCursor[T]) HasMore() bool {
+	return t.cursor.HasMore()
+}
+
+//Next decodes and returns the next result. It returns
+//ErrNoMoreResults once the cursor is exhausted.
+func (t *TypedCursor[T]) Next() (T, error) {
+	return t.NextCtx(context.Background())
+}
+
+//NextCtx is like Next but the request is bound to ctx.
+func (t *TypedCursor[T]) NextCtx(ctx context.Context) (T, error) {
+	var next T
+
+	if !t.cursor.HasMore() {
+		return next, ErrNoMoreResults
+	}
+
+	if err := t.cursor.NextCtx(ctx, &next); err != nil {
+		//ctx may be done so it can't be used to clean up
+		t.cursor.CloseCtx(context.Background())
+		if errors.Is(err, ErrNoMoreResults) {
+			return next, ErrNoMoreResults
+		}
+		return next, err
+	}
+
+	return next, nil
+}
+
+//All returns an iterator over the remaining results for use
+//with range. Iteration stops after the first error. The cursor
+//is closed when the loop ends, including on break.
+func (t *TypedCursor[T]) All() iter.Seq2[T, error] {
+	return t.AllCtx(context.Background())
+}
+
+//AllCtx is like All but the requests are bound to ctx.
+func (t *TypedCursor[T]) AllCtx(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		//ctx may be done by the time the loop ends
+		defer t.cursor.CloseCtx(context.Background())
+
+		for {
+			next, err := t.NextCtx(ctx)
+			if err == ErrNoMoreResults {
+				return
+			}
+			if !yield(next, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+//ReadAll decodes every remaining result into a slice.
+func (t *TypedCursor[T]) ReadAll() ([]T, error) {
+	return t.ReadAllCtx(context.Background())
+}
+
+//ReadAllCtx is like ReadAll but the requests are bound to ctx.
+func (t *TypedCursor[T]) ReadAllCtx(ctx context.Context) ([]T, error) {
+	results := make([]T, 0, len(t.cursor.json.Result))
+
+	for next, err := range t.AllCtx(ctx) {
+		if err != nil {
+			return results, err
+		}
+		results = append(results, next)
+	}
+
+	return results, nil
+}
+
+//ForEach calls fn with every remaining result. It stops and
+//returns the error if fn or fetching a result fails.
+func (t *TypedCursor[T]) ForEach(fn func(T) error) error {
+	return t.ForEachCtx(context.Background(), fn)
+}
+
+//ForEachCtx is like ForEach but the requests are bound to ctx.
+func (t *TypedCursor[T]) ForEachCtx(ctx context.Context, fn func(T) error) error {
+	for next, err := range t.AllCtx(ctx) {
+		if err != nil {
+			return err
+		}
+		if err = fn(next); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Close deletes the cursor on the server if it still
+//has results left.
+func (t *TypedCursor[T]) Close() error {
+	return t.cursor.Close()
+}
+
+//CloseCtx is like Close but the request is bound to ctx.
+func (t *TypedCursor[T]) CloseCtx(ctx context.Context) error {
+	return t.cursor.CloseCtx(ctx)
+}
+
+//Stream is like Cursor.Stream but every result is decoded
+//into a T before it is sent. Just like Cursor.Stream, read
+//until the channel is closed or cancel ctx.
+func (t *TypedCursor[T]) Stream(ctx context.Context, bufferSize int) (<-chan T, <-chan error) {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	//cancelled when decoding fails so the cursor stops streaming
+	ctx, cancel := context.WithCancel(ctx)
+	raw, rawErrs := t.cursor.Stream(ctx, bufferSize)
+	results := make(chan T, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(results)
+		defer cancel()
+
+		for r := range raw {
+			var next T
+			err := json.Unmarshal(r, &next)
+
+			if err == nil {
+				select {
+				case results <- next:
+					continue
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+			}
+
+			cancel()
+			for range raw {
+			}
+			errs <- wrapError(err)
+			return
+		}
+
+		if err := <-rawErrs; err != nil {
+			errs <- err
+		}
+	}()
+
+	return results, errs
+}
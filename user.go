@@ -0,0 +1,369 @@
+package arango
+
+import (
+	"context"
+	"fmt"
+	na "github.com/jmcvetta/napping"
+	"net/url"
+)
+
+//Database access levels used by GrantDatabaseAccess
+//
+//See arango manual or rest api docs for what these might mean
+const (
+	GRANT_READ_WRITE = "rw"
+	GRANT_READ_ONLY  = "ro"
+	GRANT_NONE       = "none"
+)
+
+//Small internal types used when talking to /_api/user
+//because it calls the username "user" instead of "username".
+type userPayload struct {
+	User   string      `json:"user,omitempty"`
+	Passwd string      `json:"passwd,omitempty"`
+	Active *bool       `json:"active,omitempty"`
+	Extra  interface{} `json:"extra,omitempty"`
+}
+
+type userResult struct {
+	User   string      `json:"user"`
+	Active bool        `json:"active"`
+	Extra  interface{} `json:"extra"`
+	ArangoError
+}
+
+type usersResult struct {
+	Result []userResult `json:"result"`
+	ArangoError
+}
+
+type databaseAccessResult struct {
+	Result map[string]string `json:"result"`
+	ArangoError
+}
+
+func newUserPayload(user *User) *userPayload {
+	active := user.Active
+	return &userPayload{
+		User:   user.Username,
+		Passwd: user.Passwd,
+		Active: &active,
+		Extra:  user.Extra,
+	}
+}
+
+func (r *userResult) user() *User {
+	return &User{
+		Username: r.User,
+		Active:   r.Active,
+		Extra:    r.Extra,
+	}
+}
+
+//CreateUser creates a new user using the POST /_api/user endpoint.
+//Users are global to the server so, like the other user methods,
+//this should be called on the _system database.
+//The password is never returned by arango so Passwd is left as is
+//but Active and Extra are updated with what the server stored.
+func (db *Database) CreateUser(user *User) error {
+	return db.CreateUserCtx(context.Background(), user)
+}
+
+//CreateUserCtx is like CreateUser but the request is bound to ctx.
+func (db *Database) CreateUserCtx(ctx context.Context, user *User) error {
+
+	if user == nil || user.Username == "" {
+		return newError("You must specify a username when creating a user.")
+	}
+
+	var result userResult
+
+	err := db.userRequest(ctx, "POST", "", newUserPayload(user), &result)
+
+	if err != nil {
+		return err
+	}
+
+	user.Active = result.Active
+	user.Extra = result.Extra
+	return nil
+}
+
+//User fetches a user by name using the GET /_api/user/{user} endpoint.
+//An error with a code 404 is returned if it doesn't exist.
+func (db *Database) User(username string) (*User, error) {
+	return db.UserCtx(context.Background(), username)
+}
+
+//UserCtx is like User but the request is bound to ctx.
+func (db *Database) UserCtx(ctx context.Context, username string) (*User, error) {
+
+	if username == "" {
+		return nil, newError("You must specify a username when fetching a user.")
+	}
+
+	var result userResult
+
+	err := db.userRequest(ctx, "GET", "/"+url.PathEscape(username), nil, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.user(), nil
+}
+
+//Users returns all users of the server using the GET /_api/user endpoint.
+func (db *Database) Users() ([]*User, error) {
+	return db.UsersCtx(context.Background())
+}
+
+//UsersCtx is like Users but the request is bound to ctx.
+func (db *Database) UsersCtx(ctx context.Context) ([]*User, error) {
+
+	var result usersResult
+
+	err := db.userRequest(ctx, "GET", "/", nil, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var users = make([]*User, len(result.Result))
+	for i := range result.Result {
+		users[i] = result.Result[i].user()
+	}
+
+	return users, nil
+}
+
+//ReplaceUser replaces all the data of an existing user using
+//the PUT /_api/user/{user} endpoint. A blank Passwd sets an empty password.
+func (db *Database) ReplaceUser(user *User) error {
+	return db.ReplaceUserCtx(context.Background(), user)
+}
+
+//ReplaceUserCtx is like ReplaceUser but the request is bound to ctx.
+func (db *Database) ReplaceUserCtx(ctx context.Context, user *User) error {
+
+	if user == nil || user.Username == "" {
+		return newError("You must specify a username when replacing a user.")
+	}
+
+	//The passwd has to be sent even when empty or arango keeps the old one
+	var payload = struct {
+		Passwd string      `json:"passwd"`
+		Active bool        `json:"active"`
+		Extra  interface{} `json:"extra,omitempty"`
+	}{user.Passwd, user.Active, user.Extra}
+
+	var result userResult
+
+	err := db.userRequest(ctx, "PUT", "/"+url.PathEscape(user.Username), &payload, &result)
+
+	if err != nil {
+		return err
+	}
+
+	user.Active = result.Active
+	user.Extra = result.Extra
+	return nil
+}
+
+//UpdateUser partially updates an existing user using the
+//PATCH /_api/user/{user} endpoint. Active is always sent but a blank
+//Passwd and a nil Extra are left unchanged on the server. Use
+//ChangePassword, SetUserActive or SetUserExtra to change a single field.
+func (db *Database) UpdateUser(user *User) error {
+	return db.UpdateUserCtx(context.Background(), user)
+}
+
+//UpdateUserCtx is like UpdateUser but the request is bound to ctx.
+func (db *Database) UpdateUserCtx(ctx context.Context, user *User) error {
+
+	if user == nil || user.Username == "" {
+		return newError("You must specify a username when updating a user.")
+	}
+
+	var payload = newUserPayload(user)
+	payload.User = ""
+
+	var result userResult
+
+	err := db.userRequest(ctx, "PATCH", "/"+url.PathEscape(user.Username), payload, &result)
+
+	if err != nil {
+		return err
+	}
+
+	user.Active = result.Active
+	user.Extra = result.Extra
+	return nil
+}
+
+//ChangePassword sets the password of an existing user and leaves the rest alone.
+func (db *Database) ChangePassword(username, passwd string) error {
+	return db.ChangePasswordCtx(context.Background(), username, passwd)
+}
+
+//ChangePasswordCtx is like ChangePassword but the request is bound to ctx.
+func (db *Database) ChangePasswordCtx(ctx context.Context, username, passwd string) error {
+	var payload = struct {
+		Passwd string `json:"passwd"`
+	}{passwd}
+	return db.patchUser(ctx, username, &payload)
+}
+
+//SetUserActive activates or deactivates an existing user.
+//Inactive users can't log in.
+func (db *Database) SetUserActive(username string, active bool) error {
+	return db.SetUserActiveCtx(context.Background(), username, active)
+}
+
+//SetUserActiveCtx is like SetUserActive but the request is bound to ctx.
+func (db *Database) SetUserActiveCtx(ctx context.Context, username string, active bool) error {
+	return db.patchUser(ctx, username, &userPayload{Active: &active})
+}
+
+//SetUserExtra stores extra as the extra data of an existing user.
+//Arango merges it into the extra data already stored.
+func (db *Database) SetUserExtra(username string, extra interface{}) error {
+	return db.SetUserExtraCtx(context.Background(), username, extra)
+}
+
+//SetUserExtraCtx is like SetUserExtra but the request is bound to ctx.
+func (db *Database) SetUserExtraCtx(ctx context.Context, username string, extra interface{}) error {
+	var payload = struct {
+		Extra interface{} `json:"extra"`
+	}{extra}
+	return db.patchUser(ctx, username, &payload)
+}
+
+func (db *Database) patchUser(ctx context.Context, username string, payload interface{}) error {
+
+	if username == "" {
+		return newError("You must specify a username when updating a user.")
+	}
+
+	return db.userRequest(ctx, "PATCH", "/"+url.PathEscape(username), payload, &userResult{})
+}
+
+//DeleteUser removes a user using the DELETE /_api/user/{user} endpoint.
+func (db *Database) DeleteUser(username string) error {
+	return db.DeleteUserCtx(context.Background(), username)
+}
+
+//DeleteUserCtx is like DeleteUser but the request is bound to ctx.
+func (db *Database) DeleteUserCtx(ctx context.Context, username string) error {
+
+	if username == "" {
+		return newError("You must specify a username when deleting a user.")
+	}
+
+	return db.userRequest(ctx, "DELETE", "/"+url.PathEscape(username), nil, &struct{}{})
+}
+
+//GrantDatabaseAccess sets the access level of a user for a database
+//to one of the GRANT_* constants. Servers without database level
+//permissions answer with a 404 error.
+//Uses the PUT /_api/user/{user}/database/{database} endpoint.
+func (db *Database) GrantDatabaseAccess(username, database, grant string) error {
+	return db.GrantDatabaseAccessCtx(context.Background(), username, database, grant)
+}
+
+//GrantDatabaseAccessCtx is like GrantDatabaseAccess but the request is bound to ctx.
+func (db *Database) GrantDatabaseAccessCtx(ctx context.Context, username, database, grant string) error {
+
+	if username == "" || database == "" {
+		return newError("You must specify a username and a database when granting access.")
+	}
+
+	var payload = struct {
+		Grant string `json:"grant"`
+	}{grant}
+
+	path := fmt.Sprintf("/%s/database/%s", url.PathEscape(username), url.PathEscape(database))
+
+	return db.userRequest(ctx, "PUT", path, &payload, &struct{}{})
+}
+
+//RevokeDatabaseAccess removes the access level of a user for a database
+//so the server default applies again.
+//Uses the DELETE /_api/user/{user}/database/{database} endpoint.
+func (db *Database) RevokeDatabaseAccess(username, database string) error {
+	return db.RevokeDatabaseAccessCtx(context.Background(), username, database)
+}
+
+//RevokeDatabaseAccessCtx is like RevokeDatabaseAccess but the request is bound to ctx.
+func (db *Database) RevokeDatabaseAccessCtx(ctx context.Context, username, database string) error {
+
+	if username == "" || database == "" {
+		return newError("You must specify a username and a database when revoking access.")
+	}
+
+	path := fmt.Sprintf("/%s/database/%s", url.PathEscape(username), url.PathEscape(database))
+
+	return db.userRequest(ctx, "DELETE", path, nil, &struct{}{})
+}
+
+//DatabaseAccess returns the access level of a user for every
+//database it has access to, keyed by database name.
+//Uses the GET /_api/user/{user}/database endpoint.
+func (db *Database) DatabaseAccess(username string) (map[string]string, error) {
+	return db.DatabaseAccessCtx(context.Background(), username)
+}
+
+//DatabaseAccessCtx is like DatabaseAccess but the request is bound to ctx.
+func (db *Database) DatabaseAccessCtx(ctx context.Context, username string) (map[string]string, error) {
+
+	if username == "" {
+		return nil, newError("You must specify a username when fetching database access.")
+	}
+
+	var result databaseAccessResult
+
+	err := db.userRequest(ctx, "GET", "/"+url.PathEscape(username)+"/database/", nil, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Result, nil
+}
+
+//userRequest sends one request to the /_api/user endpoints.
+func (db *Database) userRequest(ctx context.Context, method, path string, payload, result interface{}) error {
+
+	var e ArangoError
+
+	endpoint := fmt.Sprintf("%s/user%s", db.serverUrl.String(), path)
+
+	session := db.sessionCtx(ctx)
+
+	var response *na.Response
+	var err error
+
+	switch method {
+	case "GET":
+		response, err = session.Get(endpoint, nil, result, &e)
+	case "POST":
+		response, err = session.Post(endpoint, payload, result, &e)
+	case "PUT":
+		response, err = session.Put(endpoint, payload, result, &e)
+	case "PATCH":
+		response, err = session.Patch(endpoint, payload, result, &e)
+	case "DELETE":
+		response, err = session.Delete(endpoint, result, &e)
+	}
+
+	if err != nil {
+		return wrapError(err)
+	}
+
+	switch response.Status() {
+	case 200, 201, 202:
+		return nil
+	default:
+		return e
+	}
+}
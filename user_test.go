@@ -0,0 +1,221 @@
+package arango
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUserMethods(t *testing.T) {
+	setup()
+	defer teardown()
+
+	system, err := db.UseDatabase("_system")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	system.DeleteUser("dave")
+
+	user := &User{Username: "dave", Passwd: "secret", Active: true, Extra: map[string]string{"team": "ops"}}
+
+	if err = system.CreateUser(user); err != nil {
+		t.Fatal(err)
+	}
+	defer system.DeleteUser("dave")
+
+	if err = system.CreateUser(user); !errors.Is(err, ErrDuplicateUser) {
+		t.Fatalf("Expected a conflict when creating the user twice but got %v", err)
+	}
+
+	fetched, err := system.User("dave")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched.Username != "dave" || !fetched.Active || fetched.Extra.(map[string]interface{})["team"] != "ops" {
+		t.Fatalf("Unexpected user %+v", fetched)
+	}
+
+	all, err := system.Users()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, u := range all {
+		found = found || u.Username == "dave"
+	}
+
+	if !found {
+		t.Fatal("Expected dave to be listed.")
+	}
+
+	if err = system.SetUserActive("dave", false); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched, err = system.User("dave"); err != nil || fetched.Active {
+		t.Fatalf("Expected dave to be inactive: %+v %v", fetched, err)
+	}
+
+	if err = system.ChangePassword("dave", "newsecret"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = system.ReplaceUser(&User{Username: "dave", Passwd: "other", Active: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ConnDbUserPassword("http://localhost:8529", "testing", "dave", "other"); err != nil {
+		t.Fatalf("Expected dave to be able to log in with the replaced password: %v", err)
+	}
+
+	if err = system.DeleteUser("dave"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = system.User("dave"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error after deleting the user but got %v", err)
+	}
+}
+
+func TestUserRequests(t *testing.T) {
+
+	rec := newRequestRecorder("/_db/_system/_api")
+	server := fakeServer(rec.handler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"user":"eve","active":true,"extra":{"team":"ops"},"error":false,"code":201}`)
+		case "DELETE":
+			w.WriteHeader(202)
+			fmt.Fprint(w, `{"error":false,"code":202}`)
+		case "GET":
+			switch r.URL.Path {
+			case "/_db/_system/_api/user/":
+				fmt.Fprint(w, `{"result":[{"user":"root","active":true},{"user":"eve","active":false}],"error":false,"code":200}`)
+			case "/_db/_system/_api/user/eve/database/":
+				fmt.Fprint(w, `{"result":{"_system":"ro","testing":"rw"},"error":false,"code":200}`)
+			default:
+				w.WriteHeader(404)
+				fmt.Fprint(w, `{"error":true,"code":404,"errorNum":1703,"errorMessage":"user not found"}`)
+			}
+		default:
+			fmt.Fprint(w, `{"user":"eve","active":false,"error":false,"code":200}`)
+		}
+	}))
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.CreateUser(&User{}); err == nil {
+		t.Fatal("Expected an error when creating a user without a name.")
+	}
+
+	rec.reset()
+
+	user := &User{Username: "eve", Passwd: "pw", Active: true, Extra: map[string]string{"team": "ops"}}
+
+	if err = db.CreateUser(user); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.User("nobody"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error but got %v", err)
+	}
+
+	all, err := db.Users()
+
+	if err != nil || len(all) != 2 || all[1].Username != "eve" || all[1].Active {
+		t.Fatalf("Unexpected users %v %v", all, err)
+	}
+
+	if err = db.UpdateUser(&User{Username: "eve", Active: false}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.ReplaceUser(&User{Username: "eve", Active: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	db.ChangePassword("eve", "new")
+	db.SetUserActive("eve", true)
+	db.SetUserExtra("eve", map[string]int{"level": 2})
+
+	if err = db.GrantDatabaseAccess("eve", "testing", GRANT_READ_WRITE); err != nil {
+		t.Fatal(err)
+	}
+
+	access, err := db.DatabaseAccess("eve")
+
+	if err != nil || access["testing"] != GRANT_READ_WRITE {
+		t.Fatalf("Unexpected access %v %v", access, err)
+	}
+
+	if err = db.RevokeDatabaseAccess("eve", "testing"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.DeleteUser("eve"); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.check(t,
+		`POST /user {"user":"eve","passwd":"pw","active":true,"extra":{"team":"ops"}}`,
+		`GET /user/nobody`,
+		`GET /user/`,
+		`PATCH /user/eve {"active":false}`,
+		`PUT /user/eve {"passwd":"","active":true}`,
+		`PATCH /user/eve {"passwd":"new"}`,
+		`PATCH /user/eve {"active":true}`,
+		`PATCH /user/eve {"extra":{"level":2}}`,
+		`PUT /user/eve/database/testing {"grant":"rw"}`,
+		`GET /user/eve/database/`,
+		`DELETE /user/eve/database/testing`,
+		`DELETE /user/eve`,
+	)
+}
+
+func TestCreateDatabaseOptions(t *testing.T) {
+
+	var body string
+	server := fakeServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"result":true,"error":false,"code":201}`)
+	})
+	defer server.Close()
+
+	db, err := Conn(server.URL)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.CreateDatabase("sharded", &DatabaseOptions{Sharding: "single", ReplicationFactor: 2}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if body != `{"name":"sharded","options":{"sharding":"single","replicationFactor":2},"users":null}` {
+		t.Fatalf("Unexpected body %s", body)
+	}
+
+	if err = db.CreateDatabase("plain", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if body != `{"name":"plain","users":null}` {
+		t.Fatalf("Unexpected body %s", body)
+	}
+}